@@ -0,0 +1,454 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewConnectorsCommand creates the connectors command group
+func NewConnectorsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connectors",
+		Short: "Manage data connectors that sync third-party sources into data stores",
+		Long: `Manage data connectors (Jira, Confluence, SharePoint, Salesforce, ...) that sync
+third-party content into data stores.
+
+Each connector belongs to its own collection and is addressed by the collection ID.
+Credentials are never passed on the command line or stored in spec files: give them as
+Secret Manager secret version references, which the connector service resolves itself.`,
+	}
+
+	cmd.AddCommand(NewConnectorsListCommand())
+	cmd.AddCommand(NewConnectorsDescribeCommand())
+	cmd.AddCommand(NewConnectorsCreateCommand())
+	cmd.AddCommand(NewConnectorsUpdateCommand())
+	cmd.AddCommand(NewConnectorsDeleteCommand())
+	cmd.AddCommand(NewConnectorsRunsCommand())
+	cmd.AddCommand(NewConnectorsSyncCommand())
+	cmd.AddCommand(NewConnectorsPauseCommand(true))
+	cmd.AddCommand(NewConnectorsPauseCommand(false))
+
+	return cmd
+}
+
+// NewConnectorsListCommand creates the connectors list command
+func NewConnectorsListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List data connectors with their state and last sync",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			connectors, err := geminiClient.ListDataConnectors()
+			if err != nil {
+				return err
+			}
+
+			return outputDataConnectors(connectors, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewConnectorsDescribeCommand creates the connectors describe command
+func NewConnectorsDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe COLLECTION_ID",
+		Short: "Show a data connector's configuration, entities and sync status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			connector, err := geminiClient.GetDataConnector(constructCollectionName(args[0], config))
+			if err != nil {
+				return err
+			}
+
+			return outputDataConnectorDetails(connector, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewConnectorsCreateCommand creates the connectors create command
+func NewConnectorsCreateCommand() *cobra.Command {
+	var filePath string
+	var secretParams []string
+
+	cmd := &cobra.Command{
+		Use:   "create --file SPEC",
+		Short: "Create a data connector from a spec file",
+		Long: `Create a collection with a data connector from a YAML or JSON spec.
+
+Credential params go under secretParams as Secret Manager secret version references;
+params whose names look like credentials (secret, password, token, ...) are rejected.
+
+  id: jira-support
+  displayName: Jira Support
+  dataSource: jira
+  refreshInterval: 86400s
+  params:
+    instance_uri: https://example.atlassian.net
+  secretParams:
+    api_token: projects/my-project/secrets/jira-token/versions/latest
+  entities:
+    - entityName: project
+    - entityName: issue
+
+Examples:
+  gemctl connectors create --file jira.yaml
+  gemctl connectors create --file jira.yaml --secret-param api_token=projects/p/secrets/jira/versions/2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filePath == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			spec, err := client.LoadConnectorSpec(filePath)
+			if err != nil {
+				return err
+			}
+			if err := applySecretParamFlags(spec, secretParams); err != nil {
+				return err
+			}
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				fmt.Printf("Setting up %s connector %s...\n", spec.DataSource, spec.ID)
+			}
+
+			connector, err := geminiClient.CreateDataConnector(spec)
+			if err != nil {
+				return err
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				fmt.Printf("✅ Connector %s created\n\n", spec.ID)
+			}
+			return outputDataConnectorDetails(connector, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "F", "", "Connector spec file (YAML or JSON, required)")
+	cmd.Flags().StringArrayVar(&secretParams, "secret-param", nil, "Credential param as KEY=projects/P/secrets/S/versions/V (repeatable)")
+
+	return cmd
+}
+
+// NewConnectorsUpdateCommand creates the connectors update command
+func NewConnectorsUpdateCommand() *cobra.Command {
+	var filePath string
+	var params []string
+	var secretParams []string
+	spec := &client.ConnectorSpec{}
+
+	cmd := &cobra.Command{
+		Use:   "update COLLECTION_ID",
+		Short: "Update a data connector's schedule, params or entities",
+		Long: `Update the sync schedule, params, secret params or entities of a data connector.
+
+Changes can come from a spec file (--file), flags, or both; flags win. Params are
+replaced as a whole, so when updating params every credential already set on the
+connector must be passed again with --secret-param.
+
+Examples:
+  gemctl connectors update jira-support --refresh-interval 43200s
+  gemctl connectors update jira-support --secret-param api_token=projects/p/secrets/jira/versions/3
+  gemctl connectors update jira-support --file jira.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			update := spec
+			if filePath != "" {
+				loaded, err := client.LoadConnectorSpec(filePath)
+				if err != nil {
+					return err
+				}
+				if cmd.Flags().Changed("refresh-interval") {
+					loaded.RefreshInterval = spec.RefreshInterval
+				}
+				if cmd.Flags().Changed("incremental-refresh-interval") {
+					loaded.IncrementalRefreshInterval = spec.IncrementalRefreshInterval
+				}
+				update = loaded
+			}
+
+			for _, param := range params {
+				key, value, ok := strings.Cut(param, "=")
+				if !ok || strings.TrimSpace(key) == "" {
+					return fmt.Errorf("invalid param %q (use KEY=VALUE)", param)
+				}
+				if update.Params == nil {
+					update.Params = map[string]interface{}{}
+				}
+				update.Params[strings.TrimSpace(key)] = value
+			}
+			if err := applySecretParamFlags(update, secretParams); err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			connector, err := geminiClient.UpdateDataConnector(constructCollectionName(args[0], config), update)
+			if err != nil {
+				return err
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				fmt.Printf("✅ Connector %s updated\n\n", args[0])
+			}
+			return outputDataConnectorDetails(connector, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "F", "", "Connector spec file with the fields to change")
+	cmd.Flags().StringVar(&spec.RefreshInterval, "refresh-interval", "", "Full sync interval (e.g. 86400s)")
+	cmd.Flags().StringVar(&spec.IncrementalRefreshInterval, "incremental-refresh-interval", "", "Incremental sync interval (e.g. 3600s)")
+	cmd.Flags().StringArrayVar(&params, "param", nil, "Connector param as KEY=VALUE (repeatable)")
+	cmd.Flags().StringArrayVar(&secretParams, "secret-param", nil, "Credential param as KEY=projects/P/secrets/S/versions/V (repeatable)")
+
+	return cmd
+}
+
+// NewConnectorsDeleteCommand creates the connectors delete command
+func NewConnectorsDeleteCommand() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete COLLECTION_ID",
+		Short: "Delete a data connector and its collection",
+		Long: `Delete a data connector by deleting its collection.
+
+This also deletes the data stores the connector syncs into, along with their indexed
+documents. Engines that use those data stores lose access to the content.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			collectionName := constructCollectionName(args[0], config)
+			connector, err := geminiClient.GetDataConnector(collectionName)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				fmt.Printf("Connector %s (%s) syncs into data stores: %s\n",
+					args[0], connector.DataSource, valueOrPlaceholder(strings.Join(connector.ConnectorDataStoreIDs(), ", ")))
+				prompt := fmt.Sprintf("Delete connector %s together with these data stores? (y/N): ", args[0])
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Deletion cancelled.")
+					return nil
+				}
+			}
+
+			if err := geminiClient.DeleteDataConnector(collectionName); err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ Deleted connector %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewConnectorsRunsCommand creates the connectors runs command
+func NewConnectorsRunsCommand() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "runs COLLECTION_ID",
+		Short: "Show the sync run history of a data connector",
+		Long: `Show the sync run history of a data connector, newest first, with per-entity
+record counts and errors.
+
+Examples:
+  gemctl connectors runs jira-support
+  gemctl connectors runs jira-support --limit 1 --format=json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			runs, err := geminiClient.ListConnectorRuns(constructCollectionName(args[0], config))
+			if err != nil {
+				return err
+			}
+			if limit > 0 && len(runs) > limit {
+				runs = runs[:limit]
+			}
+
+			return outputConnectorRuns(runs, config.Format)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of runs to show (0 for all)")
+
+	return cmd
+}
+
+// NewConnectorsSyncCommand creates the connectors sync command
+func NewConnectorsSyncCommand() *cobra.Command {
+	var entities []string
+	var forceRefresh bool
+
+	cmd := &cobra.Command{
+		Use:   "sync COLLECTION_ID",
+		Short: "Trigger a manual sync of a data connector",
+		Long: `Trigger a manual sync run of a data connector. Use 'gemctl connectors runs' to
+follow its progress.
+
+Examples:
+  gemctl connectors sync jira-support
+  gemctl connectors sync jira-support --entities issue --force-refresh`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			run, err := geminiClient.StartConnectorRun(constructCollectionName(args[0], config), entities, forceRefresh)
+			if err != nil {
+				return err
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				fmt.Printf("✅ Sync started for connector %s\n\n", args[0])
+			}
+			return outputConnectorRuns([]*client.ConnectorRun{run}, config.Format)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&entities, "entities", nil, "Entities to sync (default: all)")
+	cmd.Flags().BoolVar(&forceRefresh, "force-refresh", false, "Re-fetch content of unchanged documents")
+
+	return cmd
+}
+
+// NewConnectorsPauseCommand creates the connectors pause or resume command
+func NewConnectorsPauseCommand(pause bool) *cobra.Command {
+	use, short, done := "resume", "Resume scheduled syncs of a data connector", "resumed"
+	if pause {
+		use, short, done = "pause", "Pause scheduled syncs of a data connector", "paused"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " COLLECTION_ID",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			connector, err := geminiClient.SetDataConnectorPaused(constructCollectionName(args[0], config), pause)
+			if err != nil {
+				return err
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputDataConnectorDetails(connector, config.Format)
+			}
+			fmt.Printf("✅ Connector %s %s\n", args[0], done)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// applySecretParamFlags adds KEY=SECRET_VERSION flags to the spec's secret params.
+func applySecretParamFlags(spec *client.ConnectorSpec, secretParams []string) error {
+	for _, param := range secretParams {
+		key, ref, ok := strings.Cut(param, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(ref) == "" {
+			return fmt.Errorf("invalid secret param %q (use KEY=projects/P/secrets/S/versions/V)", param)
+		}
+		if spec.SecretParams == nil {
+			spec.SecretParams = map[string]string{}
+		}
+		spec.SecretParams[strings.TrimSpace(key)] = strings.TrimSpace(ref)
+	}
+	return nil
+}
+
+func constructCollectionName(collectionID string, config *client.Config) string {
+	if strings.Contains(collectionID, "/") {
+		return collectionID
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/collections/%s",
+		config.ProjectID, config.Location, collectionID)
+}
@@ -14,7 +14,12 @@ func NewDataStoresCommand() *cobra.Command {
 
 	dataStoresCmd.AddCommand(NewDataStoresListCommand())
 	dataStoresCmd.AddCommand(NewDataStoresDescribeCommand())
+	dataStoresCmd.AddCommand(NewDataStoresCreateCommand())
 	dataStoresCmd.AddCommand(NewDataStoresCreateFromGCSCommand())
+	dataStoresCmd.AddCommand(NewDataStoresProcessingConfigCommand())
+	dataStoresCmd.AddCommand(NewDataStoresSitesCommand())
+	dataStoresCmd.AddCommand(NewDataStoresCompletionCommand())
+	dataStoresCmd.AddCommand(NewDataStoresUserEventsCommand())
 	dataStoresCmd.AddCommand(NewDataStoresListDocumentsCommand())
 	dataStoresCmd.AddCommand(NewDataStoresDeleteCommand())
 
@@ -0,0 +1,289 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewDataStoresCompletionCommand creates the data-stores completion command group
+func NewDataStoresCompletionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion",
+		Short: "Manage autocomplete deny lists and suggestions of a data store",
+		Long: `Manage the autocomplete (completeQuery) behavior of a data store.
+
+Deny lists block phrases from ever being suggested. Custom suggestions add curated
+completions on top of the suggestions generated from documents or search history.
+Use 'query' to preview what users will see for a prefix.`,
+	}
+
+	denyListCmd := &cobra.Command{
+		Use:   "deny-list",
+		Short: "Import or purge the suggestion deny list",
+	}
+	denyListCmd.AddCommand(NewDataStoresCompletionDenyListImportCommand())
+	denyListCmd.AddCommand(NewDataStoresCompletionPurgeCommand("deny list entries", func(c *client.GeminiClient, name string) (*client.CompletionPurgeResult, error) {
+		return c.PurgeSuggestionDenyList(name)
+	}))
+
+	suggestionsCmd := &cobra.Command{
+		Use:   "suggestions",
+		Short: "Import or purge custom completion suggestions",
+	}
+	suggestionsCmd.AddCommand(NewDataStoresCompletionSuggestionsImportCommand())
+	suggestionsCmd.AddCommand(NewDataStoresCompletionPurgeCommand("custom suggestions", func(c *client.GeminiClient, name string) (*client.CompletionPurgeResult, error) {
+		return c.PurgeCompletionSuggestions(name)
+	}))
+
+	cmd.AddCommand(denyListCmd)
+	cmd.AddCommand(suggestionsCmd)
+	cmd.AddCommand(NewDataStoresCompletionQueryCommand())
+
+	return cmd
+}
+
+// NewDataStoresCompletionDenyListImportCommand creates the deny-list import command
+func NewDataStoresCompletionDenyListImportCommand() *cobra.Command {
+	var match string
+
+	cmd := &cobra.Command{
+		Use:   "import DATA_STORE_ID SOURCE",
+		Short: "Replace the suggestion deny list from a local file or GCS",
+		Long: `Replace the suggestion deny list of a data store. Phrases on the deny list are never
+returned by autocomplete.
+
+SOURCE is a local file or a gs:// URI. Local .jsonl files hold one entry per line:
+
+  {"blockPhrase": "free download", "matchOperator": "CONTAINS"}
+
+Any other local file holds one phrase per line, matched with --match. Blank lines and
+lines starting with # are ignored. GCS files must be JSONL; up to 1000 entries can be
+imported from a local file.
+
+Examples:
+  gemctl data-stores completion deny-list import my-store blocked.txt --match=contains
+  gemctl data-stores completion deny-list import my-store deny-list.jsonl
+  gemctl data-stores completion deny-list import my-store gs://my-bucket/deny-list.jsonl`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var entries []client.DenyListEntry
+			gcsURI := ""
+			if strings.HasPrefix(args[1], "gs://") {
+				gcsURI = args[1]
+			} else {
+				var err error
+				entries, err = client.LoadDenyListFile(args[1], match)
+				if err != nil {
+					return err
+				}
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			result, err := geminiClient.ImportSuggestionDenyList(constructDataStoreName(args[0], config), entries, gcsURI)
+			if err != nil {
+				return err
+			}
+
+			return outputCompletionImportResult(result, "deny list entries", config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&match, "match", "exact", "Match operator for plain-text phrases (exact, contains)")
+
+	return cmd
+}
+
+// NewDataStoresCompletionSuggestionsImportCommand creates the suggestions import command
+func NewDataStoresCompletionSuggestionsImportCommand() *cobra.Command {
+	var languageCode string
+
+	cmd := &cobra.Command{
+		Use:   "import DATA_STORE_ID SOURCE",
+		Short: "Import custom completion suggestions from a local file or GCS",
+		Long: `Import custom autocomplete suggestions into a data store.
+
+SOURCE is a local file or a gs:// URI. Local .jsonl files hold one suggestion per line:
+
+  {"suggestion": "pricing plans", "languageCode": "en", "globalScore": 0.9,
+   "alternativePhrases": ["plans", "price"], "groupId": "pricing"}
+
+Any other local file holds one suggestion per line. --language-code applies to
+suggestions that do not set one. GCS files must be JSONL; up to 1000 suggestions can be
+imported from a local file.
+
+Examples:
+  gemctl data-stores completion suggestions import my-store suggestions.txt --language-code=en
+  gemctl data-stores completion suggestions import my-store gs://my-bucket/suggestions.jsonl`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var suggestions []client.CompletionSuggestion
+			gcsURI := ""
+			if strings.HasPrefix(args[1], "gs://") {
+				gcsURI = args[1]
+			} else {
+				var err error
+				suggestions, err = client.LoadCompletionSuggestionsFile(args[1], languageCode)
+				if err != nil {
+					return err
+				}
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			result, err := geminiClient.ImportCompletionSuggestions(constructDataStoreName(args[0], config), suggestions, gcsURI)
+			if err != nil {
+				return err
+			}
+
+			return outputCompletionImportResult(result, "suggestions", config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&languageCode, "language-code", "", "BCP-47 language code for suggestions without one")
+
+	return cmd
+}
+
+// NewDataStoresCompletionPurgeCommand creates a purge command for the deny list or custom suggestions
+func NewDataStoresCompletionPurgeCommand(what string, purge func(*client.GeminiClient, string) (*client.CompletionPurgeResult, error)) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "purge DATA_STORE_ID",
+		Short: fmt.Sprintf("Remove all %s from a data store", what),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Remove all %s from %s? (y/N): ", what, args[0])
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Purge cancelled.")
+					return nil
+				}
+			}
+
+			result, err := purge(geminiClient, constructDataStoreName(args[0], config))
+			if err != nil {
+				return err
+			}
+
+			return outputCompletionPurgeResult(result, what, config.Format)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewDataStoresCompletionQueryCommand creates the completion query command
+func NewDataStoresCompletionQueryCommand() *cobra.Command {
+	var opts client.CompletionQueryOptions
+
+	cmd := &cobra.Command{
+		Use:   "query DATA_STORE_ID [PREFIX]",
+		Short: "Preview autocomplete suggestions for a prefix",
+		Long: `Call completeQuery and show the suggestions users would see for a prefix.
+
+Without PREFIX the command reads prefixes from standard input, one per line, and
+prints the suggestions for each until end of input.
+
+Query models: document, document-completable, search-history, user-event.
+
+Examples:
+  gemctl data-stores completion query my-store "pric"
+  gemctl data-stores completion query my-store --query-model=search-history
+  printf 'how\nwhere\n' | gemctl data-stores completion query my-store --format=json`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			dataStoreName := constructDataStoreName(args[0], config)
+			if len(args) == 2 {
+				result, err := geminiClient.CompleteQuery(dataStoreName, args[1], opts)
+				if err != nil {
+					return err
+				}
+				return outputCompletionQueryResult(result, config.Format)
+			}
+
+			interactive := config.Format != "json" && config.Format != "yaml"
+			if interactive {
+				fmt.Fprintln(os.Stderr, "Type a prefix and press Enter to preview suggestions (Ctrl-D to exit).")
+			}
+			scanner := bufio.NewScanner(os.Stdin)
+			for {
+				if interactive {
+					fmt.Fprint(os.Stderr, "> ")
+				}
+				if !scanner.Scan() {
+					break
+				}
+				prefix := strings.TrimSpace(scanner.Text())
+				if prefix == "" {
+					continue
+				}
+				result, err := geminiClient.CompleteQuery(dataStoreName, prefix, opts)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					continue
+				}
+				if err := outputCompletionQueryResult(result, config.Format); err != nil {
+					return err
+				}
+			}
+			if interactive {
+				fmt.Fprintln(os.Stderr)
+			}
+			return scanner.Err()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.QueryModel, "query-model", "", "Autocomplete model (document, document-completable, search-history, user-event)")
+	cmd.Flags().StringVar(&opts.UserPseudoID, "user-pseudo-id", "", "Visitor ID used for personalized suggestions")
+	cmd.Flags().BoolVar(&opts.IncludeTailSuggestions, "include-tail-suggestions", false, "Return tail suggestions when no suggestion matches the full prefix")
+
+	return cmd
+}
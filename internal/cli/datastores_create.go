@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewDataStoresCreateCommand creates the data-stores create command
+func NewDataStoresCreateCommand() *cobra.Command {
+	var specPath string
+	var noWait bool
+	flags := &client.DataStoreSpec{}
+	workspace := &client.DataStoreWorkspaceSpec{}
+	processing := &client.DocumentProcessingSpec{}
+
+	cmd := &cobra.Command{
+		Use:   "create [DATA_STORE_ID]",
+		Short: "Create a data store of any content type and industry vertical",
+		Long: `Create a data store from flags or a YAML spec. Flags override values from the spec.
+
+Content configs:
+  no-content        Structured data without document content (alias: structured)
+  content-required  Unstructured documents (default)
+  public-website    Public website search
+  google-workspace  Google Workspace data (requires --workspace-type)
+
+Industry verticals: generic (default), media, healthcare-fhir.
+Solution types: search (default), recommendation, chat, generative-chat.
+
+Document processing:
+  --parser            digital, ocr, or layout
+  --chunk-size        Layout-based chunk size in tokens (100-500, layout parser only)
+  --ancestor-headings Include ancestor headings in chunks (layout parser only)
+
+Example spec:
+  id: policies
+  displayName: HR Policies
+  contentConfig: content-required
+  aclEnabled: true
+  documentProcessing:
+    parser: layout
+    chunkSize: 500
+    includeAncestorHeadings: true
+
+Examples:
+  gemctl data-stores create products --content no-content --display-name "Products"
+  gemctl data-stores create site --content public-website --advanced-site-search
+  gemctl data-stores create patients --vertical healthcare-fhir --content no-content
+  gemctl data-stores create drive --content google-workspace --workspace-type drive
+  gemctl data-stores create docs --parser layout --chunk-size 500 --ancestor-headings
+  gemctl data-stores create -F datastore.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec := &client.DataStoreSpec{}
+			if specPath != "" {
+				loaded, err := client.LoadDataStoreSpec(specPath)
+				if err != nil {
+					return err
+				}
+				spec = loaded
+			}
+			if len(args) == 1 {
+				spec.ID = args[0]
+			}
+			applyDataStoreSpecFlags(cmd, spec, flags, workspace, processing)
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			dataStore, err := geminiClient.CreateDataStore(spec, !noWait)
+			if err != nil {
+				return err
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				if noWait {
+					fmt.Printf("✅ Data store creation started: %s\n\n", dataStore.Name)
+				} else {
+					fmt.Printf("✅ Successfully created data store: %s\n\n", dataStore.Name)
+				}
+			}
+			return outputDataStoreDetails(dataStore, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&specPath, "file", "F", "", "YAML spec describing the data store")
+	cmd.Flags().StringVar(&flags.DisplayName, "display-name", "", "Display name (defaults to the data store ID)")
+	cmd.Flags().StringVar(&flags.ContentConfig, "content", "", "Content config: no-content, content-required, public-website, google-workspace")
+	cmd.Flags().StringVar(&flags.IndustryVertical, "vertical", "", "Industry vertical: generic, media, healthcare-fhir")
+	cmd.Flags().StringSliceVar(&flags.SolutionTypes, "solution-types", nil, "Comma-separated solution types: search, recommendation, chat, generative-chat")
+	cmd.Flags().BoolVar(&flags.AclEnabled, "acl-enabled", false, "Enforce document access control lists")
+	cmd.Flags().BoolVar(&flags.AdvancedSiteSearch, "advanced-site-search", false, "Enable advanced site search (public-website only)")
+	cmd.Flags().StringVar(&workspace.Type, "workspace-type", "", "Workspace source: drive, mail, sites, calendar, chat, groups, keep, people")
+	cmd.Flags().StringVar(&workspace.DasherCustomerID, "workspace-customer-id", "", "Google Workspace customer ID")
+	cmd.Flags().StringVar(&workspace.SuperAdminEmailAddress, "workspace-admin-email", "", "Workspace super admin email address")
+	cmd.Flags().StringVar(&workspace.SuperAdminServiceAccount, "workspace-admin-service-account", "", "Workspace super admin service account")
+	cmd.Flags().StringVar(&processing.Parser, "parser", "", "Default document parser: digital, ocr, layout")
+	cmd.Flags().BoolVar(&processing.UseNativeText, "use-native-text", false, "Use native PDF text alongside OCR (ocr parser only)")
+	cmd.Flags().BoolVar(&processing.EnableTableAnnotation, "table-annotation", false, "Annotate tables (layout parser only)")
+	cmd.Flags().BoolVar(&processing.EnableImageAnnotation, "image-annotation", false, "Annotate images (layout parser only)")
+	cmd.Flags().Int64Var(&processing.ChunkSize, "chunk-size", 0, "Layout-based chunk size in tokens (100-500)")
+	cmd.Flags().BoolVar(&processing.IncludeAncestorHeadings, "ancestor-headings", false, "Include ancestor headings in chunks")
+	cmd.Flags().BoolVar(&noWait, "no-wait", false, "Return once creation has started instead of waiting for it to finish")
+
+	return cmd
+}
+
+// applyDataStoreSpecFlags copies explicitly set flags over the spec.
+func applyDataStoreSpecFlags(cmd *cobra.Command, spec, flags *client.DataStoreSpec, workspace *client.DataStoreWorkspaceSpec, processing *client.DocumentProcessingSpec) {
+	changed := cmd.Flags().Changed
+
+	if changed("display-name") {
+		spec.DisplayName = flags.DisplayName
+	}
+	if changed("content") {
+		spec.ContentConfig = flags.ContentConfig
+	}
+	if changed("vertical") {
+		spec.IndustryVertical = flags.IndustryVertical
+	}
+	if changed("solution-types") {
+		spec.SolutionTypes = flags.SolutionTypes
+	}
+	if changed("acl-enabled") {
+		spec.AclEnabled = flags.AclEnabled
+	}
+	if changed("advanced-site-search") {
+		spec.AdvancedSiteSearch = flags.AdvancedSiteSearch
+	}
+
+	for _, name := range []string{"workspace-type", "workspace-customer-id", "workspace-admin-email", "workspace-admin-service-account"} {
+		if changed(name) && spec.Workspace == nil {
+			spec.Workspace = &client.DataStoreWorkspaceSpec{}
+		}
+	}
+	if changed("workspace-type") {
+		spec.Workspace.Type = workspace.Type
+	}
+	if changed("workspace-customer-id") {
+		spec.Workspace.DasherCustomerID = workspace.DasherCustomerID
+	}
+	if changed("workspace-admin-email") {
+		spec.Workspace.SuperAdminEmailAddress = workspace.SuperAdminEmailAddress
+	}
+	if changed("workspace-admin-service-account") {
+		spec.Workspace.SuperAdminServiceAccount = workspace.SuperAdminServiceAccount
+	}
+
+	for _, name := range []string{"parser", "use-native-text", "table-annotation", "image-annotation", "chunk-size", "ancestor-headings"} {
+		if changed(name) && spec.DocumentProcessing == nil {
+			spec.DocumentProcessing = &client.DocumentProcessingSpec{}
+		}
+	}
+	if changed("parser") {
+		spec.DocumentProcessing.Parser = processing.Parser
+	}
+	if changed("use-native-text") {
+		spec.DocumentProcessing.UseNativeText = processing.UseNativeText
+	}
+	if changed("table-annotation") {
+		spec.DocumentProcessing.EnableTableAnnotation = processing.EnableTableAnnotation
+	}
+	if changed("image-annotation") {
+		spec.DocumentProcessing.EnableImageAnnotation = processing.EnableImageAnnotation
+	}
+	if changed("chunk-size") {
+		spec.DocumentProcessing.ChunkSize = processing.ChunkSize
+	}
+	if changed("ancestor-headings") {
+		spec.DocumentProcessing.IncludeAncestorHeadings = processing.IncludeAncestorHeadings
+	}
+}
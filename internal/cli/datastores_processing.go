@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewDataStoresProcessingConfigCommand creates the data-stores processing-config command group
+func NewDataStoresProcessingConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "processing-config",
+		Short: "Show or update a data store's document processing config",
+		Long: `Show or update how a data store parses and chunks documents.
+
+The default parser and per-file-type parser overrides can be changed at any time and
+apply to documents imported afterwards. Chunking is fixed when the data store is created.`,
+	}
+
+	cmd.AddCommand(NewDataStoresProcessingConfigGetCommand())
+	cmd.AddCommand(NewDataStoresProcessingConfigUpdateCommand())
+
+	return cmd
+}
+
+// NewDataStoresProcessingConfigGetCommand creates the processing-config get command
+func NewDataStoresProcessingConfigGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get DATA_STORE_ID",
+		Short: "Show the parser and chunking settings of a data store",
+		Long: `Show the default parser, per-file-type parser overrides, and layout-based chunking
+settings of a data store.
+
+Examples:
+  gemctl data-stores processing-config get my-datastore
+  gemctl data-stores processing-config get my-datastore --format=json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			processingConfig, err := geminiClient.GetDocumentProcessingConfig(constructDataStoreName(args[0], config))
+			if err != nil {
+				return err
+			}
+
+			return outputDocumentProcessingConfig(args[0], processingConfig, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewDataStoresProcessingConfigUpdateCommand creates the processing-config update command
+func NewDataStoresProcessingConfigUpdateCommand() *cobra.Command {
+	var overrides []string
+	var removeOverrides []string
+	defaults := &client.DocumentProcessingSpec{}
+
+	cmd := &cobra.Command{
+		Use:   "update DATA_STORE_ID",
+		Short: "Update the default parser or per-file-type parser overrides",
+		Long: `Update the default parser or per-file-type parser overrides of a data store.
+
+Overrides are given as FILE_TYPE=PARSER, where FILE_TYPE is one of docx, html, pdf,
+pptx, xlsm, xlsx and PARSER is digital, ocr (pdf only), or layout.
+
+Chunking (--chunk-size, --ancestor-headings) cannot be changed after a data store is
+created, and a data store with chunking enabled must keep the layout parser as its
+default. Create a new data store to change these settings.
+
+Examples:
+  gemctl data-stores processing-config update my-datastore --parser layout --table-annotation
+  gemctl data-stores processing-config update my-datastore --override pdf=ocr --override html=layout
+  gemctl data-stores processing-config update my-datastore --remove-override pdf`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			update := &client.DocumentProcessingUpdate{RemoveOverrides: removeOverrides}
+
+			for _, name := range []string{"parser", "use-native-text", "table-annotation", "image-annotation", "chunk-size", "ancestor-headings"} {
+				if cmd.Flags().Changed(name) {
+					update.Default = defaults
+					break
+				}
+			}
+
+			if len(overrides) > 0 {
+				update.Overrides = map[string]*client.DocumentProcessingSpec{}
+				for _, override := range overrides {
+					fileType, parser, ok := strings.Cut(override, "=")
+					if !ok || strings.TrimSpace(fileType) == "" || strings.TrimSpace(parser) == "" {
+						return fmt.Errorf("invalid override %q (use FILE_TYPE=PARSER)", override)
+					}
+					update.Overrides[strings.TrimSpace(fileType)] = &client.DocumentProcessingSpec{Parser: strings.TrimSpace(parser)}
+				}
+			}
+
+			if update.Default == nil && len(update.Overrides) == 0 && len(update.RemoveOverrides) == 0 {
+				return fmt.Errorf("no changes requested; use --parser, --override or --remove-override")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			updated, err := geminiClient.UpdateDocumentProcessingConfig(constructDataStoreName(args[0], config), update)
+			if err != nil {
+				return err
+			}
+
+			if config.Format != "json" && config.Format != "yaml" {
+				fmt.Println("✅ Document processing config updated. Changes apply to documents imported from now on.")
+				fmt.Println()
+			}
+			return outputDocumentProcessingConfig(args[0], updated, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&defaults.Parser, "parser", "", "Default parser: digital, ocr, layout")
+	cmd.Flags().BoolVar(&defaults.UseNativeText, "use-native-text", false, "Use native PDF text alongside OCR (ocr parser only)")
+	cmd.Flags().BoolVar(&defaults.EnableTableAnnotation, "table-annotation", false, "Annotate tables (layout parser only)")
+	cmd.Flags().BoolVar(&defaults.EnableImageAnnotation, "image-annotation", false, "Annotate images (layout parser only)")
+	cmd.Flags().Int64Var(&defaults.ChunkSize, "chunk-size", 0, "Chunk size (cannot be changed after creation)")
+	cmd.Flags().BoolVar(&defaults.IncludeAncestorHeadings, "ancestor-headings", false, "Include ancestor headings (cannot be changed after creation)")
+	cmd.Flags().StringArrayVar(&overrides, "override", nil, "Per-file-type parser override as FILE_TYPE=PARSER (repeatable)")
+	cmd.Flags().StringSliceVar(&removeOverrides, "remove-override", nil, "File types whose parser override should be removed")
+
+	return cmd
+}
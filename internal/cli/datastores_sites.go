@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewDataStoresSitesCommand creates the data-stores sites command group
+func NewDataStoresSitesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sites",
+		Short: "Manage target sites of website data stores",
+		Long: `Manage the target sites indexed by a website (public-website) data store.
+
+Target sites are URI patterns such as www.example.com/docs/* that are included in or
+excluded from the index. Exact-match sites index a single page.`,
+	}
+
+	cmd.AddCommand(NewDataStoresSitesListCommand())
+	cmd.AddCommand(NewDataStoresSitesAddCommand())
+	cmd.AddCommand(NewDataStoresSitesRemoveCommand())
+	cmd.AddCommand(NewDataStoresSitesBatchAddCommand())
+	cmd.AddCommand(NewDataStoresSitesVerifyCommand())
+	cmd.AddCommand(NewDataStoresSitesRecrawlCommand())
+
+	return cmd
+}
+
+// NewDataStoresSitesListCommand creates the sites list command
+func NewDataStoresSitesListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list DATA_STORE_ID",
+		Short: "List target sites with their indexing and verification status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sites, err := geminiClient.ListTargetSites(constructDataStoreName(args[0], config))
+			if err != nil {
+				return err
+			}
+
+			return outputTargetSites(sites, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewDataStoresSitesAddCommand creates the sites add command
+func NewDataStoresSitesAddCommand() *cobra.Command {
+	var exclude bool
+	var exact bool
+
+	cmd := &cobra.Command{
+		Use:   "add DATA_STORE_ID URI_PATTERN",
+		Short: "Add an include or exclude target site",
+		Long: `Add a target site to a website data store.
+
+Examples:
+  gemctl data-stores sites add my-site "www.example.com/docs/*"
+  gemctl data-stores sites add my-site "www.example.com/docs/archive/*" --exclude
+  gemctl data-stores sites add my-site www.example.com/pricing --exact`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			spec := client.TargetSiteSpec{URIPattern: args[1], Type: client.TargetSiteInclude, ExactMatch: exact}
+			if exclude {
+				spec.Type = client.TargetSiteExclude
+			}
+
+			site, err := geminiClient.AddTargetSite(constructDataStoreName(args[0], config), spec)
+			if err != nil {
+				return err
+			}
+
+			return outputTargetSites([]*client.TargetSite{site}, config.Format)
+		},
+	}
+
+	cmd.Flags().BoolVar(&exclude, "exclude", false, "Exclude pages matching the pattern instead of including them")
+	cmd.Flags().BoolVar(&exact, "exact", false, "Match the URI exactly instead of as a prefix pattern")
+
+	return cmd
+}
+
+// NewDataStoresSitesRemoveCommand creates the sites remove command
+func NewDataStoresSitesRemoveCommand() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "remove DATA_STORE_ID SITE",
+		Short: "Remove a target site by ID or URI pattern",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Remove target site %s from %s? (y/N): ", args[1], args[0])
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Removal cancelled.")
+					return nil
+				}
+			}
+
+			site, err := geminiClient.RemoveTargetSite(constructDataStoreName(args[0], config), args[1])
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ Removed target site %s\n", site.URIPattern)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewDataStoresSitesBatchAddCommand creates the sites batch-add command
+func NewDataStoresSitesBatchAddCommand() *cobra.Command {
+	var filePath string
+
+	cmd := &cobra.Command{
+		Use:   "batch-add DATA_STORE_ID --file FILE",
+		Short: "Add target sites in bulk from a text file",
+		Long: `Add target sites in bulk from a text file with one URI pattern per line.
+
+Each line may be followed by "exclude" and/or "exact". Blank lines and lines starting
+with # are ignored. Sites are submitted in batches of 20.
+
+  # intranet
+  intranet.example.com/*
+  intranet.example.com/private/* exclude
+  www.example.com/pricing exact
+
+Examples:
+  gemctl data-stores sites batch-add my-site --file sites.txt`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filePath == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			specs, err := client.LoadTargetSitesFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sites, err := geminiClient.BatchAddTargetSites(constructDataStoreName(args[0], config), specs)
+			if len(sites) > 0 {
+				if outputErr := outputTargetSites(sites, config.Format); outputErr != nil {
+					return outputErr
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("added %d of %d target site(s): %w", len(sites), len(specs), err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "F", "", "Text file with one URI pattern per line (required)")
+
+	return cmd
+}
+
+// NewDataStoresSitesVerifyCommand creates the sites verify command
+func NewDataStoresSitesVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify DATA_STORE_ID",
+		Short: "Verify domain ownership of all target sites",
+		Long: `Run domain ownership verification for all target sites and show the result.
+
+Unverified sites are not indexed. Add the verification record for the domain in
+Google Search Console before re-running this command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sites, err := geminiClient.VerifyTargetSites(constructDataStoreName(args[0], config))
+			if err != nil {
+				return err
+			}
+
+			return outputTargetSites(sites, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewDataStoresSitesRecrawlCommand creates the sites recrawl command
+func NewDataStoresSitesRecrawlCommand() *cobra.Command {
+	var filePath string
+	var operationName string
+	var wait bool
+	var pollInterval time.Duration
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "recrawl DATA_STORE_ID [URI...]",
+		Short: "Recrawl URIs and track the recrawl operation",
+		Long: `Request a recrawl of specific URIs and optionally wait for it to finish.
+
+URIs can be given as arguments or in a file (--file, one URI per line). Use --status
+with an operation name to check the progress of an earlier recrawl.
+
+Examples:
+  gemctl data-stores sites recrawl my-site https://www.example.com/docs/page --wait
+  gemctl data-stores sites recrawl my-site --file uris.txt
+  gemctl data-stores sites recrawl my-site --status OPERATION_NAME --wait`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			uris := args[1:]
+			if filePath != "" {
+				fileURIs, err := readURIList(filePath)
+				if err != nil {
+					return err
+				}
+				uris = append(uris, fileURIs...)
+			}
+			if operationName == "" && len(uris) == 0 {
+				return fmt.Errorf("provide URIs to recrawl, --file, or --status")
+			}
+			if operationName != "" && len(uris) > 0 {
+				return fmt.Errorf("--status cannot be combined with URIs")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if operationName == "" {
+				operationName, err = geminiClient.RecrawlURIs(constructDataStoreName(args[0], config), uris)
+				if err != nil {
+					return err
+				}
+				if config.Format != "json" && config.Format != "yaml" {
+					fmt.Printf("Recrawl of %d URI(s) started: %s\n", len(uris), operationName)
+				}
+			}
+
+			if !wait {
+				status, err := geminiClient.GetRecrawlStatus(operationName)
+				if err != nil {
+					return err
+				}
+				return outputRecrawlStatus(status, config.Format)
+			}
+
+			showProgress := config.Format != "json" && config.Format != "yaml"
+			status, err := geminiClient.WaitForRecrawl(operationName, pollInterval, timeout, func(status *client.RecrawlStatus) {
+				if showProgress {
+					fmt.Fprintf(os.Stderr, "  %d succeeded, %d pending of %d valid URI(s)...\n",
+						status.SuccessCount, status.PendingCount, status.ValidURIs)
+				}
+			})
+			if status != nil {
+				if outputErr := outputRecrawlStatus(status, config.Format); outputErr != nil {
+					return outputErr
+				}
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "F", "", "Text file with one URI per line")
+	cmd.Flags().StringVar(&operationName, "status", "", "Check an existing recrawl operation instead of starting one")
+	cmd.Flags().BoolVar(&wait, "wait", false, "Poll the recrawl operation until it finishes")
+	cmd.Flags().DurationVar(&pollInterval, "poll-interval", 10*time.Second, "Polling interval with --wait")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait with --wait")
+
+	return cmd
+}
+
+func readURIList(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	uris := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		uris = append(uris, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return uris, nil
+}
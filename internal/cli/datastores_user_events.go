@@ -0,0 +1,271 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewDataStoresUserEventsCommand creates the data-stores user-events command group
+func NewDataStoresUserEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "user-events",
+		Aliases: []string{"events"},
+		Short:   "Write, import, and purge user events of a data store",
+		Long: `Send search, view, click, and conversion events that train ranking and
+recommendation models.
+
+Events are read as JSONL (one UserEvent JSON object per line) from a file or from
+standard input. Each event is validated locally before it is sent: the event type must
+be supported, userPseudoId is required, conversion events need conversionType, and
+view-item, add-to-cart, purchase, and media events must reference documents.`,
+	}
+
+	cmd.AddCommand(NewDataStoresUserEventsWriteCommand())
+	cmd.AddCommand(NewDataStoresUserEventsImportCommand())
+	cmd.AddCommand(NewDataStoresUserEventsPurgeCommand())
+
+	return cmd
+}
+
+// NewDataStoresUserEventsWriteCommand creates the user-events write command
+func NewDataStoresUserEventsWriteCommand() *cobra.Command {
+	var concurrency int
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "write DATA_STORE_ID [FILE|-]",
+		Short: "Write user events one by one and report per-event errors",
+		Long: `Write user events individually, with several requests in flight, and report the
+result of each event. Reads standard input when FILE is omitted or "-".
+
+Invalid events are reported and skipped; the remaining events are still written.
+
+Examples:
+  gemctl data-stores user-events write my-store events.jsonl --concurrency=8
+  cat events.jsonl | gemctl data-stores user-events write my-store
+  gemctl data-stores user-events write my-store events.jsonl --dry-run`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			records, err := readUserEventSource(args[1:])
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			valid, results := client.ValidateUserEvents(records, false)
+			if !dryRun && len(valid) > 0 {
+				geminiClient, err := client.NewGeminiClient(config)
+				if err != nil {
+					return fmt.Errorf("failed to create client: %w", err)
+				}
+
+				results = append(results, geminiClient.WriteUserEvents(constructDataStoreName(args[0], config), valid, concurrency)...)
+			} else {
+				for _, record := range valid {
+					results = append(results, client.UserEventResult{
+						Line:         record.Line,
+						EventType:    record.Event.EventType,
+						UserPseudoID: record.Event.UserPseudoId,
+						Status:       "valid",
+					})
+				}
+			}
+			client.SortUserEventResults(results)
+
+			if err := outputUserEventResults(results, config.Format); err != nil {
+				return err
+			}
+			return userEventFailures(results)
+		},
+	}
+
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "Number of concurrent write requests")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate events locally without sending them")
+
+	return cmd
+}
+
+// NewDataStoresUserEventsImportCommand creates the user-events import command
+func NewDataStoresUserEventsImportCommand() *cobra.Command {
+	var batchSize int
+	var errorPrefix string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import DATA_STORE_ID [FILE|-|gs://URI]",
+		Short: "Import historical user events in batches",
+		Long: `Import user events in bulk, for example to backfill historical analytics.
+
+Local events (from FILE, or standard input when FILE is omitted or "-") are validated,
+then imported inline in batches of --batch-size. Imported events must carry eventTime.
+A gs:// URI is imported directly by the service; --error-prefix sets a gs:// folder
+for its error reports.
+
+Examples:
+  gemctl data-stores user-events import my-store history.jsonl --batch-size=500
+  gemctl data-stores user-events import my-store gs://my-bucket/events/*.jsonl --error-prefix=gs://my-bucket/errors/`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gcsURI := ""
+			if len(args) == 2 && strings.HasPrefix(args[1], "gs://") {
+				gcsURI = args[1]
+			}
+			if gcsURI != "" && dryRun {
+				return fmt.Errorf("--dry-run validates local events and cannot be used with a gs:// source")
+			}
+
+			var valid []client.UserEventRecord
+			var invalid []client.UserEventResult
+			if gcsURI == "" {
+				records, err := readUserEventSource(args[1:])
+				if err != nil {
+					return err
+				}
+				valid, invalid = client.ValidateUserEvents(records, true)
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				if err := outputUserEventValidation(len(valid), invalid, config.Format); err != nil {
+					return err
+				}
+				return invalidUserEvents(invalid, len(valid)+len(invalid))
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			dataStoreName := constructDataStoreName(args[0], config)
+			var result *client.UserEventImportResult
+			if gcsURI != "" {
+				result, err = geminiClient.ImportUserEventsFromGCS(dataStoreName, gcsURI, errorPrefix)
+			} else if len(valid) > 0 {
+				result, err = geminiClient.ImportUserEvents(dataStoreName, valid, batchSize)
+			} else {
+				result = &client.UserEventImportResult{Operations: []string{}}
+			}
+			if result != nil {
+				result.Invalid = invalid
+				if outputErr := outputUserEventImportResult(result, config.Format); outputErr != nil {
+					return outputErr
+				}
+			}
+			if err != nil {
+				return err
+			}
+			return invalidUserEvents(invalid, len(valid)+len(invalid))
+		},
+	}
+
+	cmd.Flags().IntVar(&batchSize, "batch-size", 1000, "Number of events per inline import request")
+	cmd.Flags().StringVar(&errorPrefix, "error-prefix", "", "gs:// folder for error reports of a GCS import")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate local events without importing them")
+
+	return cmd
+}
+
+// NewDataStoresUserEventsPurgeCommand creates the user-events purge command
+func NewDataStoresUserEventsPurgeCommand() *cobra.Command {
+	var filter string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "purge DATA_STORE_ID --filter FILTER",
+		Short: "Permanently delete user events matching a filter",
+		Long: `Permanently delete user events matching a filter. The filter can combine eventType,
+eventTime, userPseudoId, and userId; use "*" for the past 30 days of events. A purge
+covers at most 30 days.
+
+Examples:
+  gemctl data-stores user-events purge my-store --filter='eventType = "search"'
+  gemctl data-stores user-events purge my-store --filter='userPseudoId = "synthetic-visitor-1"' --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if strings.TrimSpace(filter) == "" {
+				return fmt.Errorf("--filter is required")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Permanently delete user events in %s matching %s? (y/N): ", args[0], filter)
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Purge cancelled.")
+					return nil
+				}
+			}
+
+			result, err := geminiClient.PurgeUserEvents(constructDataStoreName(args[0], config), filter)
+			if err != nil {
+				return err
+			}
+
+			return outputUserEventPurgeResult(result, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&filter, "filter", "", "Filter selecting the events to delete (required)")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// readUserEventSource reads events from the file argument, or from standard
+// input when it is missing or "-".
+func readUserEventSource(args []string) ([]client.UserEventRecord, error) {
+	if len(args) == 0 || args[0] == "-" {
+		return client.ReadUserEvents(os.Stdin, "stdin")
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer file.Close()
+	return client.ReadUserEvents(file, args[0])
+}
+
+func userEventFailures(results []client.UserEventResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Status == "error" || result.Status == "invalid" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d user event(s) failed", failed, len(results))
+	}
+	return nil
+}
+
+func invalidUserEvents(invalid []client.UserEventResult, total int) error {
+	if len(invalid) > 0 {
+		return fmt.Errorf("%d of %d user event(s) failed validation", len(invalid), total)
+	}
+	return nil
+}
@@ -17,7 +17,11 @@ func NewEnginesCommand() *cobra.Command {
 	enginesCmd.AddCommand(NewEnginesCreateCommand())
 	enginesCmd.AddCommand(NewEnginesDeleteCommand())
 	enginesCmd.AddCommand(NewEnginesAgentsCommand())
+	enginesCmd.AddCommand(NewEnginesAssistantsCommand())
 	enginesCmd.AddCommand(NewEnginesFeaturesCommand())
+	enginesCmd.AddCommand(NewEnginesServingConfigsCommand())
+	enginesCmd.AddCommand(NewEnginesControlsCommand())
+	enginesCmd.AddCommand(NewEnginesSessionsCommand())
 	enginesCmd.AddCommand(NewEnginesWorkforceCommand())
 	enginesCmd.AddCommand(NewEnginesSnapshotCommand())
 
@@ -12,28 +12,46 @@ import (
 func NewEnginesAgentsCommand() *cobra.Command {
 	agentsCmd := &cobra.Command{
 		Use:   "agents",
-		Short: "Manage Dialogflow agents connected to an engine assistant",
-		Long: `Manage Dialogflow agents connected to a Gemini Enterprise engine's default assistant.
+		Short: "Manage Dialogflow and ADK agents connected to an engine assistant",
+		Long: `Manage Dialogflow and ADK (Agent Engine) agents connected to a Gemini Enterprise engine assistant.
 
-Use these commands to list, describe, register, update, and delete agent registrations
-using the Discovery Engine v1alpha assistant APIs.`,
+Use these commands to list, describe, register, update, delete, share, and publish agent
+registrations using the Discovery Engine v1alpha assistant APIs. Agents are registered
+against the engine's default assistant unless --assistant selects another one.`,
 	}
 
+	agentsCmd.PersistentFlags().String("assistant", "", "Assistant ID the agents are registered against (default: default_assistant)")
+
 	agentsCmd.AddCommand(NewEnginesAgentsListCommand())
 	agentsCmd.AddCommand(NewEnginesAgentsDescribeCommand())
 	agentsCmd.AddCommand(NewEnginesAgentsCreateCommand())
 	agentsCmd.AddCommand(NewEnginesAgentsUpdateCommand())
 	agentsCmd.AddCommand(NewEnginesAgentsDeleteCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsApplyCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsValidateCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsTestCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsShareCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsUnshareCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsPublishCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsUnpublishCommand())
+	agentsCmd.AddCommand(NewEnginesAgentsAccessListCommand())
 
 	return agentsCmd
 }
 
+// agentsParent resolves ENGINE_ID and the --assistant flag to the assistant
+// that agents are registered against
+func agentsParent(cmd *cobra.Command, engineID string, config *client.Config) string {
+	assistantID, _ := cmd.Flags().GetString("assistant")
+	return client.ConstructAssistantName(constructEngineName(engineID, config), assistantID)
+}
+
 // NewEnginesAgentsListCommand creates the engines agents list command
 func NewEnginesAgentsListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list ENGINE_ID",
 		Short: "List agents registered to an engine",
-		Long: `List all Dialogflow agents that are registered with an engine's default assistant.
+		Long: `List all agents that are registered with an engine's default assistant.
 
 Examples:
   gemctl engines agents list my-engine
@@ -51,9 +69,9 @@ Examples:
 			}
 
 			engineID := args[0]
-			engineName := constructEngineName(engineID, config)
+			parent := agentsParent(cmd, engineID, config)
 
-			agents, err := geminiClient.ListAgents(engineName)
+			agents, err := geminiClient.ListAgents(parent)
 			if err != nil {
 				return fmt.Errorf("failed to list agents: %w", err)
 			}
@@ -70,7 +88,7 @@ func NewEnginesAgentsDescribeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "describe ENGINE_ID AGENT_ID",
 		Short: "Describe an agent registration",
-		Long: `Describe a specific Dialogflow agent registration for an engine assistant.
+		Long: `Describe a specific agent registration for an engine assistant.
 
 Examples:
   gemctl engines agents describe my-engine 12345678901234567890
@@ -87,8 +105,8 @@ Examples:
 				return fmt.Errorf("failed to create client: %w", err)
 			}
 
-			engineName := constructEngineName(args[0], config)
-			agentName := client.ConstructAgentName(engineName, args[1])
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
 
 			agent, err := geminiClient.GetAgent(agentName)
 			if err != nil {
@@ -117,13 +135,18 @@ func NewEnginesAgentsCreateCommand() *cobra.Command {
 	var dialogflowProject string
 	var dialogflowLocation string
 	var dialogflowAgentID string
+	var adkReasoningEngine string
+	var toolDescription string
+	var authorizations []string
+	var skipValidation bool
 
 	cmd := &cobra.Command{
 		Use:   "create ENGINE_ID",
-		Short: "Register a Dialogflow agent with an engine assistant",
-		Long: `Register a Dialogflow agent so it can be invoked by the engine's default assistant.
+		Short: "Register a Dialogflow or ADK agent with an engine assistant",
+		Long: `Register a Dialogflow or ADK agent so it can be invoked by the engine's default assistant.
 
-You must provide a display name, description, reasoning engine, and the Dialogflow agent to link.
+You must provide a display name, description, and either a Dialogflow agent (with its reasoning engine)
+or an ADK agent deployed to Vertex AI Agent Engine (--adk-reasoning-engine).
 
 Examples:
   gemctl engines agents create my-engine \
@@ -139,7 +162,14 @@ Examples:
     --description="Handles support FAQs" \
     --reasoning-engine=projects/my-project/locations/global/collections/default_collection/engines/my-engine \
     --dialogflow-agent=projects/my-dialogflow-project/locations/global/agents/1234567890 \
-    --icon-uri=https://example.com/icon.png`,
+    --icon-uri=https://example.com/icon.png
+
+  gemctl engines agents create my-engine \
+    --display-name="Travel Planner" \
+    --description="Plans business trips" \
+    --adk-reasoning-engine=projects/my-project/locations/us-central1/reasoningEngines/1234567890 \
+    --tool-description="Use for booking flights and hotels" \
+    --authorization=projects/123456/locations/global/authorizations/travel-oauth`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config, err := getConfigFromFlags(cmd)
@@ -155,16 +185,23 @@ Examples:
 				return fmt.Errorf("--description is required")
 			}
 
-			if strings.TrimSpace(reasoningEngine) == "" {
-				return fmt.Errorf("--reasoning-engine is required")
+			dialogflowResource, err := resolveDialogflowAgentResource(dialogflowAgent, dialogflowProject, dialogflowLocation, dialogflowAgentID)
+			if err != nil {
+				return err
 			}
 
-			dialogflowResource, err := resolveDialogflowAgentResource(dialogflowAgent, dialogflowProject, dialogflowLocation, dialogflowAgentID)
+			adkDefinition, err := buildAdkAgentDefinition(adkReasoningEngine, toolDescription, description, authorizations)
 			if err != nil {
 				return err
 			}
-			if dialogflowResource == "" {
-				return fmt.Errorf("dialogflow agent information is required via --dialogflow-agent or the project/location/agent ID flags")
+
+			switch {
+			case dialogflowResource != "" && adkDefinition != nil:
+				return fmt.Errorf("specify either a Dialogflow agent or --adk-reasoning-engine, not both")
+			case dialogflowResource == "" && adkDefinition == nil:
+				return fmt.Errorf("agent definition is required via --dialogflow-agent, the Dialogflow project/location/agent ID flags, or --adk-reasoning-engine")
+			case dialogflowResource != "" && strings.TrimSpace(reasoningEngine) == "":
+				return fmt.Errorf("--reasoning-engine is required for Dialogflow agents")
 			}
 
 			geminiClient, err := client.NewGeminiClient(config)
@@ -172,15 +209,24 @@ Examples:
 				return fmt.Errorf("failed to create client: %w", err)
 			}
 
-			engineName := constructEngineName(args[0], config)
+			if dialogflowResource != "" && !skipValidation {
+				if err := preflightDialogflowAgent(geminiClient, dialogflowResource); err != nil {
+					return err
+				}
+			}
+
+			parent := agentsParent(cmd, args[0], config)
 
 			createInput := &client.AgentCreateInput{
-				DisplayName:     displayName,
-				Description:     description,
-				ReasoningEngine: reasoningEngine,
-				DialogflowAgentDefinition: &client.DialogflowAgentDefinition{
+				DisplayName:        displayName,
+				Description:        description,
+				ReasoningEngine:    reasoningEngine,
+				AdkAgentDefinition: adkDefinition,
+			}
+			if dialogflowResource != "" {
+				createInput.DialogflowAgentDefinition = &client.DialogflowAgentDefinition{
 					DialogflowAgent: dialogflowResource,
-				},
+				}
 			}
 
 			if iconURI != "" || iconContent != "" {
@@ -190,7 +236,7 @@ Examples:
 				}
 			}
 
-			agent, err := geminiClient.CreateAgent(engineName, createInput)
+			agent, err := geminiClient.CreateAgent(parent, createInput)
 			if err != nil {
 				return fmt.Errorf("failed to create agent: %w", err)
 			}
@@ -201,13 +247,17 @@ Examples:
 
 	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name for the agent (required)")
 	cmd.Flags().StringVar(&description, "description", "", "Description of the agent's purpose (required)")
-	cmd.Flags().StringVar(&reasoningEngine, "reasoning-engine", "", "Fully qualified reasoning engine resource (required)")
+	cmd.Flags().StringVar(&reasoningEngine, "reasoning-engine", "", "Fully qualified reasoning engine resource (required for Dialogflow agents)")
 	cmd.Flags().StringVar(&iconURI, "icon-uri", "", "Public URI for the agent icon")
 	cmd.Flags().StringVar(&iconContent, "icon-content", "", "Base64-encoded image content for the agent icon")
 	cmd.Flags().StringVar(&dialogflowAgent, "dialogflow-agent", "", "Fully qualified Dialogflow agent resource name")
 	cmd.Flags().StringVar(&dialogflowProject, "dialogflow-project-id", "", "Dialogflow agent project ID")
 	cmd.Flags().StringVar(&dialogflowLocation, "dialogflow-location", "", "Dialogflow agent location (e.g., global, us-central1)")
 	cmd.Flags().StringVar(&dialogflowAgentID, "dialogflow-agent-id", "", "Dialogflow agent ID")
+	cmd.Flags().StringVar(&adkReasoningEngine, "adk-reasoning-engine", "", "Agent Engine resource hosting an ADK agent (projects/P/locations/L/reasoningEngines/ID)")
+	cmd.Flags().StringVar(&toolDescription, "tool-description", "", "Description the assistant uses to decide when to call the ADK agent (defaults to --description)")
+	cmd.Flags().StringSliceVar(&authorizations, "authorization", nil, "Authorization resource granted to the ADK agent (repeatable)")
+	cmd.Flags().BoolVar(&skipValidation, "skip-validation", false, "Skip Dialogflow agent pre-flight checks")
 
 	return cmd
 }
@@ -224,11 +274,15 @@ func NewEnginesAgentsUpdateCommand() *cobra.Command {
 	var dialogflowProject string
 	var dialogflowLocation string
 	var dialogflowAgentID string
+	var adkReasoningEngine string
+	var toolDescription string
+	var authorizations []string
+	var skipValidation bool
 
 	cmd := &cobra.Command{
 		Use:   "update ENGINE_ID AGENT_ID",
 		Short: "Update an existing agent registration",
-		Long: `Update metadata or Dialogflow linkage for a registered agent.
+		Long: `Update metadata, Dialogflow linkage, or ADK agent settings for a registered agent.
 
 Use the flags to specify which fields should be updated. At least one field must be changed.
 
@@ -239,7 +293,9 @@ Examples:
     --dialogflow-project-id=my-dialogflow-project \
     --dialogflow-location=us-central1 \
     --dialogflow-agent-id=abcd1234 \
-    --reasoning-engine=projects/my-project/locations/us/collections/default_collection/engines/my-engine`,
+    --reasoning-engine=projects/my-project/locations/us/collections/default_collection/engines/my-engine
+
+  gemctl engines agents update my-engine 1234567890 --tool-description="Use for expense questions"`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config, err := getConfigFromFlags(cmd)
@@ -252,8 +308,8 @@ Examples:
 				return fmt.Errorf("failed to create client: %w", err)
 			}
 
-			engineName := constructEngineName(args[0], config)
-			agentName := client.ConstructAgentName(engineName, args[1])
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
 
 			updateInput := &client.AgentUpdateInput{}
 			updateMask := make([]string, 0)
@@ -299,12 +355,58 @@ Examples:
 				if dialogflowResource == "" {
 					return fmt.Errorf("dialogflow agent information is required when updating the Dialogflow linkage")
 				}
+				if !skipValidation {
+					if err := preflightDialogflowAgent(geminiClient, dialogflowResource); err != nil {
+						return err
+					}
+				}
 				updateInput.DialogflowAgentDefinition = &client.DialogflowAgentDefinition{
 					DialogflowAgent: dialogflowResource,
 				}
 				updateMask = append(updateMask, "dialogflowAgentDefinition.dialogflowAgent")
 			}
 
+			if cmd.Flags().Changed("adk-reasoning-engine") ||
+				cmd.Flags().Changed("tool-description") ||
+				cmd.Flags().Changed("authorization") {
+				if updateInput.DialogflowAgentDefinition != nil {
+					return fmt.Errorf("Dialogflow and ADK flags cannot be combined")
+				}
+				current, err := geminiClient.GetAgent(agentName)
+				if err != nil {
+					return fmt.Errorf("failed to get agent: %w", err)
+				}
+				if current == nil {
+					return fmt.Errorf("agent not found: %s", args[1])
+				}
+				if current.Kind() == client.AgentKindDialogflow {
+					return fmt.Errorf("agent %s is a Dialogflow agent; ADK flags do not apply", args[1])
+				}
+
+				definition := &client.AdkAgentDefinition{}
+				if current.AdkAgentDefinition != nil {
+					definition = current.AdkAgentDefinition
+				}
+				if cmd.Flags().Changed("adk-reasoning-engine") {
+					resource, err := resolveAdkReasoningEngineResource(adkReasoningEngine)
+					if err != nil {
+						return err
+					}
+					if resource == "" {
+						return fmt.Errorf("--adk-reasoning-engine cannot be empty when specified")
+					}
+					definition.ProvisionedReasoningEngine = &client.ProvisionedReasoningEngine{ReasoningEngine: resource}
+				}
+				if cmd.Flags().Changed("tool-description") {
+					definition.ToolSettings = &client.AdkToolSettings{ToolDescription: toolDescription}
+				}
+				if cmd.Flags().Changed("authorization") {
+					definition.Authorizations = authorizations
+				}
+				updateInput.AdkAgentDefinition = definition
+				updateMask = append(updateMask, "adkAgentDefinition")
+			}
+
 			if len(updateMask) == 0 {
 				return fmt.Errorf("no fields specified for update")
 			}
@@ -328,6 +430,10 @@ Examples:
 	cmd.Flags().StringVar(&dialogflowProject, "dialogflow-project-id", "", "Dialogflow agent project ID")
 	cmd.Flags().StringVar(&dialogflowLocation, "dialogflow-location", "", "Dialogflow agent location (e.g., global, us-central1)")
 	cmd.Flags().StringVar(&dialogflowAgentID, "dialogflow-agent-id", "", "Dialogflow agent ID")
+	cmd.Flags().StringVar(&adkReasoningEngine, "adk-reasoning-engine", "", "Updated Agent Engine resource for an ADK agent")
+	cmd.Flags().StringVar(&toolDescription, "tool-description", "", "Updated ADK tool description")
+	cmd.Flags().StringSliceVar(&authorizations, "authorization", nil, "Replacement authorization resources for an ADK agent (repeatable)")
+	cmd.Flags().BoolVar(&skipValidation, "skip-validation", false, "Skip Dialogflow agent pre-flight checks")
 
 	return cmd
 }
@@ -339,7 +445,7 @@ func NewEnginesAgentsDeleteCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete ENGINE_ID AGENT_ID",
 		Short: "Delete an agent registration",
-		Long: `Delete a Dialogflow agent registration from an engine assistant.
+		Long: `Delete an agent registration from an engine assistant.
 
 Examples:
   gemctl engines agents delete my-engine 1234567890
@@ -356,8 +462,8 @@ Examples:
 				return fmt.Errorf("failed to create client: %w", err)
 			}
 
-			engineName := constructEngineName(args[0], config)
-			agentName := client.ConstructAgentName(engineName, args[1])
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
 
 			agent, err := geminiClient.GetAgent(agentName)
 			if err != nil {
@@ -420,3 +526,41 @@ func resolveDialogflowAgentResource(fullResource, projectID, location, agentID s
 
 	return fmt.Sprintf("projects/%s/locations/%s/agents/%s", projectID, location, agentID), nil
 }
+
+func resolveAdkReasoningEngineResource(resource string) (string, error) {
+	resource = strings.TrimSpace(resource)
+	if resource == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(resource, "projects/") || !strings.Contains(resource, "/reasoningEngines/") {
+		return "", fmt.Errorf("ADK reasoning engine must be in the form projects/PROJECT/locations/LOCATION/reasoningEngines/ENGINE_ID")
+	}
+	return resource, nil
+}
+
+func buildAdkAgentDefinition(reasoningEngine, toolDescription, fallbackDescription string, authorizations []string) (*client.AdkAgentDefinition, error) {
+	resource, err := resolveAdkReasoningEngineResource(reasoningEngine)
+	if err != nil {
+		return nil, err
+	}
+	if resource == "" {
+		if strings.TrimSpace(toolDescription) != "" || len(authorizations) > 0 {
+			return nil, fmt.Errorf("--tool-description and --authorization require --adk-reasoning-engine")
+		}
+		return nil, nil
+	}
+
+	if strings.TrimSpace(toolDescription) == "" {
+		toolDescription = fallbackDescription
+	}
+
+	return &client.AdkAgentDefinition{
+		ToolSettings: &client.AdkToolSettings{
+			ToolDescription: toolDescription,
+		},
+		ProvisionedReasoningEngine: &client.ProvisionedReasoningEngine{
+			ReasoningEngine: resource,
+		},
+		Authorizations: authorizations,
+	}, nil
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// agentSpecFile is the on-disk format accepted by 'engines agents apply'.
+type agentSpecFile struct {
+	Agents []agentSpec `yaml:"agents"`
+}
+
+// agentSpec describes a single agent registration in an apply file.
+type agentSpec struct {
+	DisplayName     string            `yaml:"displayName"`
+	Description     string            `yaml:"description"`
+	Icon            string            `yaml:"icon,omitempty"`
+	ReasoningEngine string            `yaml:"reasoningEngine,omitempty"`
+	DialogflowAgent string            `yaml:"dialogflowAgent,omitempty"`
+	Adk             *agentSpecAdk     `yaml:"adk,omitempty"`
+	Labels          map[string]string `yaml:"labels,omitempty"`
+	Annotations     map[string]string `yaml:"annotations,omitempty"`
+}
+
+type agentSpecAdk struct {
+	ReasoningEngine string   `yaml:"reasoningEngine"`
+	ToolDescription string   `yaml:"toolDescription,omitempty"`
+	Authorizations  []string `yaml:"authorizations,omitempty"`
+}
+
+// NewEnginesAgentsApplyCommand creates the engines agents apply command
+func NewEnginesAgentsApplyCommand() *cobra.Command {
+	var file string
+	var prune bool
+	var dryRun bool
+	var force bool
+	var concurrency int
+
+	cmd := &cobra.Command{
+		Use:   "apply ENGINE_ID --file FILE",
+		Short: "Create or update agents from a YAML definition file",
+		Long: `Bring an engine's agent registrations in line with a YAML definition file.
+
+Each definition is matched against the registered agents (by Dialogflow agent, ADK reasoning
+engine, or display name). Missing agents are created and changed agents are updated.
+Fields a definition leaves out are not changed on the registered agent.
+Registered agents that are not in the file are deleted only with --prune.
+
+Icons may be an http(s) URL or a path to an image file relative to the YAML file;
+files are embedded as Base64 content.
+
+  agents:
+    - displayName: Support Bot
+      description: Handles support FAQs
+      icon: icons/support.png
+      dialogflowAgent: projects/df-project/locations/global/agents/1234
+    - displayName: Travel Planner
+      description: Plans business trips
+      adk:
+        reasoningEngine: projects/my-project/locations/us-central1/reasoningEngines/5678
+        toolDescription: Use for booking flights and hotels
+      labels:
+        team: travel
+
+Examples:
+  gemctl engines agents apply my-engine -F agents.yaml --dry-run
+  gemctl engines agents apply my-engine -F agents.yaml --prune --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			desired, err := loadAgentSpecs(file)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			parent := agentsParent(cmd, args[0], config)
+			changes, err := geminiClient.PlanAgents(parent, desired, prune)
+			if err != nil {
+				return err
+			}
+
+			if len(changes) == 0 {
+				fmt.Println("Agents already match the definition file; nothing to do.")
+				return nil
+			}
+
+			if dryRun || !force {
+				if err := outputAgentApplyResults(client.PlannedAgentResults(changes), config.Format); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			if !force {
+				if proceed, err := promptForConfirmation("Apply these changes? (y/N): "); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Apply cancelled.")
+					return nil
+				}
+			}
+
+			results := geminiClient.ApplyAgentChanges(parent, changes, concurrency)
+			if err := outputAgentApplyResults(results, config.Format); err != nil {
+				return err
+			}
+
+			for _, result := range results {
+				if result.Status == "error" {
+					return fmt.Errorf("one or more agent changes failed")
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with agent definitions (required)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete registered agents that are not in the file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show planned changes")
+	cmd.Flags().BoolVar(&force, "force", false, "Apply changes without confirmation")
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "Number of agent changes applied in parallel")
+
+	return cmd
+}
+
+func loadAgentSpecs(path string) ([]*client.Agent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent definitions %s: %w", path, err)
+	}
+
+	var specFile agentSpecFile
+	if err := yaml.Unmarshal(data, &specFile); err != nil {
+		return nil, fmt.Errorf("failed to parse agent definitions: %w", err)
+	}
+	if len(specFile.Agents) == 0 {
+		return nil, fmt.Errorf("no agents defined in %s", path)
+	}
+
+	baseDir := filepath.Dir(path)
+	agents := make([]*client.Agent, 0, len(specFile.Agents))
+	for i, spec := range specFile.Agents {
+		agent, err := spec.toAgent(baseDir)
+		if err != nil {
+			return nil, fmt.Errorf("agent %d (%s): %w", i+1, spec.DisplayName, err)
+		}
+		agents = append(agents, agent)
+	}
+	return agents, nil
+}
+
+func (spec agentSpec) toAgent(baseDir string) (*client.Agent, error) {
+	if strings.TrimSpace(spec.DisplayName) == "" {
+		return nil, fmt.Errorf("displayName is required")
+	}
+	if strings.TrimSpace(spec.Description) == "" {
+		return nil, fmt.Errorf("description is required")
+	}
+
+	agent := &client.Agent{
+		DisplayName:     spec.DisplayName,
+		Description:     spec.Description,
+		ReasoningEngine: spec.ReasoningEngine,
+		Labels:          spec.Labels,
+		Annotations:     spec.Annotations,
+	}
+
+	if spec.DialogflowAgent != "" {
+		resource, err := resolveDialogflowAgentResource(spec.DialogflowAgent, "", "", "")
+		if err != nil {
+			return nil, err
+		}
+		agent.DialogflowAgentDefinition = &client.DialogflowAgentDefinition{DialogflowAgent: resource}
+	}
+
+	if spec.Adk != nil {
+		if agent.DialogflowAgentDefinition != nil {
+			return nil, fmt.Errorf("dialogflowAgent and adk cannot both be set")
+		}
+		definition, err := buildAdkAgentDefinition(spec.Adk.ReasoningEngine, spec.Adk.ToolDescription, spec.Description, spec.Adk.Authorizations)
+		if err != nil {
+			return nil, err
+		}
+		if definition == nil {
+			return nil, fmt.Errorf("adk.reasoningEngine is required")
+		}
+		agent.AdkAgentDefinition = definition
+	}
+
+	if agent.DialogflowAgentDefinition == nil && agent.AdkAgentDefinition == nil {
+		return nil, fmt.Errorf("either dialogflowAgent or adk must be set")
+	}
+
+	if spec.Icon != "" {
+		icon, err := resolveAgentIcon(spec.Icon, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		agent.Icon = icon
+	}
+
+	return agent, nil
+}
+
+// resolveAgentIcon returns an icon URI for URLs or Base64 content for local files.
+func resolveAgentIcon(value, baseDir string) (*client.AgentIcon, error) {
+	if strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "http://") {
+		return &client.AgentIcon{URI: value}, nil
+	}
+
+	path := value
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read icon %s: %w", path, err)
+	}
+	return &client.AgentIcon{Content: base64.StdEncoding.EncodeToString(data)}, nil
+}
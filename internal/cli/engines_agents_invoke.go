@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// agentTestSuite is the on-disk format accepted by 'engines agents test --suite'.
+type agentTestSuite struct {
+	Tests []agentTestCase `yaml:"tests"`
+}
+
+// agentTestCase is a single prompt with the substrings its response must contain.
+type agentTestCase struct {
+	Name   string   `yaml:"name"`
+	Prompt string   `yaml:"prompt"`
+	Expect []string `yaml:"expect,omitempty"`
+}
+
+// agentTestOutcome reports the result of one suite case.
+type agentTestOutcome struct {
+	Name    string               `json:"name"`
+	Passed  bool                 `json:"passed"`
+	Missing []string             `json:"missing,omitempty"`
+	Error   string               `json:"error,omitempty"`
+	Result  *client.AssistResult `json:"result,omitempty"`
+}
+
+// NewEnginesAgentsTestCommand creates the engines agents test command
+func NewEnginesAgentsTestCommand() *cobra.Command {
+	var prompt string
+	var suitePath string
+
+	cmd := &cobra.Command{
+		Use:   "test ENGINE_ID AGENT_ID",
+		Short: "Send a test prompt to an agent through the assistant",
+		Long: `Send a prompt to an agent through the engine assistant's streamAssist endpoint.
+
+The streamed response is printed as it arrives, followed by the latency and any errors
+reported by the assistant or the agent.
+
+Use --suite to run a regression suite of prompts with expected substrings:
+
+  tests:
+    - name: greeting
+      prompt: Hello, what can you do?
+      expect: ["invoice"]
+    - name: totals
+      prompt: What is the total on invoice 42?
+      expect: ["$1,200"]
+
+Examples:
+  gemctl engines agents test my-engine 1234567890 --prompt="What can you help with?"
+  gemctl engines agents test my-engine 1234567890 --suite=agent-tests.yaml`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (prompt == "") == (suitePath == "") {
+				return fmt.Errorf("provide exactly one of --prompt or --suite")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			parent := agentsParent(cmd, args[0], config)
+			agentID := args[1]
+
+			if suitePath != "" {
+				return runAgentTestSuite(geminiClient, parent, agentID, suitePath, config.Format)
+			}
+
+			streaming := config.Format != "json" && config.Format != "yaml"
+			var onText func(string)
+			if streaming {
+				onText = func(text string) { fmt.Print(text) }
+			}
+
+			result, err := geminiClient.StreamAssist(parent, agentID, prompt, onText)
+			if err != nil {
+				return fmt.Errorf("failed to query agent: %w", err)
+			}
+
+			if !streaming {
+				if config.Format == "json" {
+					return outputJSON(result, config.Format)
+				}
+				return outputYAML(result)
+			}
+
+			fmt.Println()
+			outputAssistResultSummary(result)
+			if result.Failed() {
+				return fmt.Errorf("agent returned errors")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&prompt, "prompt", "", "Prompt to send to the agent")
+	cmd.Flags().StringVar(&suitePath, "suite", "", "YAML file with prompts and expected substrings")
+
+	return cmd
+}
+
+func runAgentTestSuite(geminiClient *client.GeminiClient, parent, agentID, suitePath, format string) error {
+	data, err := os.ReadFile(suitePath)
+	if err != nil {
+		return fmt.Errorf("failed to read test suite %s: %w", suitePath, err)
+	}
+
+	var suite agentTestSuite
+	if err := yaml.Unmarshal(data, &suite); err != nil {
+		return fmt.Errorf("failed to parse test suite: %w", err)
+	}
+	if len(suite.Tests) == 0 {
+		return fmt.Errorf("no tests defined in %s", suitePath)
+	}
+
+	outcomes := make([]agentTestOutcome, 0, len(suite.Tests))
+	failed := 0
+	for i, testCase := range suite.Tests {
+		name := testCase.Name
+		if name == "" {
+			name = fmt.Sprintf("test-%d", i+1)
+		}
+
+		outcome := agentTestOutcome{Name: name}
+		result, err := geminiClient.StreamAssist(parent, agentID, testCase.Prompt, nil)
+		outcome.Result = result
+		switch {
+		case err != nil:
+			outcome.Error = err.Error()
+		case result.Failed():
+			outcome.Error = strings.Join(result.Errors, "; ")
+			if outcome.Error == "" {
+				outcome.Error = "assistant state " + result.State
+			}
+		default:
+			lower := strings.ToLower(result.Response)
+			for _, expected := range testCase.Expect {
+				if !strings.Contains(lower, strings.ToLower(expected)) {
+					outcome.Missing = append(outcome.Missing, expected)
+				}
+			}
+			outcome.Passed = len(outcome.Missing) == 0
+		}
+
+		if !outcome.Passed {
+			failed++
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	if err := outputAgentTestOutcomes(outcomes, format); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d agent tests failed", failed, len(outcomes))
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewEnginesAgentsShareCommand creates the engines agents share command
+func NewEnginesAgentsShareCommand() *cobra.Command {
+	return newAgentAccessCommand(true)
+}
+
+// NewEnginesAgentsUnshareCommand creates the engines agents unshare command
+func NewEnginesAgentsUnshareCommand() *cobra.Command {
+	return newAgentAccessCommand(false)
+}
+
+func newAgentAccessCommand(grant bool) *cobra.Command {
+	var users []string
+	var groups []string
+	var members []string
+	var allUsers bool
+	var role string
+
+	use := "share ENGINE_ID AGENT_ID"
+	short := "Share an agent with users or groups"
+	long := `Grant users or groups access to an agent registration.
+
+When a workforce identity pool is configured (see 'engines workforce show'), --user and --group
+values are mapped to principals in that pool. Otherwise they are treated as Google identities.
+Use --member to pass raw IAM member strings.
+
+Examples:
+  gemctl engines agents share my-engine 1234567890 --user=alice@example.com --group=finance@example.com
+  gemctl engines agents share my-engine 1234567890 --all-users
+  gemctl engines agents share my-engine 1234567890 --member=principalSet://iam.googleapis.com/locations/global/workforcePools/pool/group/sales`
+	if !grant {
+		use = "unshare ENGINE_ID AGENT_ID"
+		short = "Revoke agent access from users or groups"
+		long = `Revoke access to an agent registration from users or groups.
+
+Principals are resolved the same way as for 'engines agents share'.
+
+Examples:
+  gemctl engines agents unshare my-engine 1234567890 --user=alice@example.com
+  gemctl engines agents unshare my-engine 1234567890 --all-users`
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(users) == 0 && len(groups) == 0 && len(members) == 0 && !allUsers {
+				return fmt.Errorf("provide at least one of --user, --group, --member or --all-users")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			principals, err := resolveAgentPrincipals(geminiClient, users, groups, members, allUsers)
+			if err != nil {
+				return err
+			}
+
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
+
+			var policy *client.IamPolicy
+			if grant {
+				policy, err = geminiClient.ShareAgent(agentName, role, principals)
+			} else {
+				policy, err = geminiClient.UnshareAgent(agentName, role, principals)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to update agent access: %w", err)
+			}
+
+			return outputAgentAccess(client.AgentAccessEntries(policy), config.Format)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&users, "user", nil, "User identifier (email or workforce subject) (repeatable)")
+	cmd.Flags().StringSliceVar(&groups, "group", nil, "Group identifier (email or workforce group ID) (repeatable)")
+	cmd.Flags().StringSliceVar(&members, "member", nil, "Raw IAM member string (repeatable)")
+	cmd.Flags().BoolVar(&allUsers, "all-users", false, "All users in the configured workforce pool")
+	cmd.Flags().StringVar(&role, "role", client.AgentUserRole, "IAM role to grant or revoke")
+
+	return cmd
+}
+
+// NewEnginesAgentsPublishCommand creates the engines agents publish command
+func NewEnginesAgentsPublishCommand() *cobra.Command {
+	return newAgentPublishCommand(true)
+}
+
+// NewEnginesAgentsUnpublishCommand creates the engines agents unpublish command
+func NewEnginesAgentsUnpublishCommand() *cobra.Command {
+	return newAgentPublishCommand(false)
+}
+
+func newAgentPublishCommand(publish bool) *cobra.Command {
+	use := "publish ENGINE_ID AGENT_ID"
+	short := "Publish an agent to the agent gallery"
+	long := `Publish an agent so that users with access can find it in the agent gallery.
+
+Examples:
+  gemctl engines agents publish my-engine 1234567890`
+	if !publish {
+		use = "unpublish ENGINE_ID AGENT_ID"
+		short = "Remove an agent from the agent gallery"
+		long = `Remove an agent from the agent gallery without deleting its registration.
+
+Examples:
+  gemctl engines agents unpublish my-engine 1234567890`
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
+
+			var agent *client.Agent
+			if publish {
+				agent, err = geminiClient.PublishAgent(agentName)
+			} else {
+				agent, err = geminiClient.UnpublishAgent(agentName)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to update agent publication: %w", err)
+			}
+			if agent == nil {
+				return fmt.Errorf("agent not found: %s", args[1])
+			}
+
+			return outputAgentDetails(agent, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesAgentsAccessListCommand creates the engines agents access-list command
+func NewEnginesAgentsAccessListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-list ENGINE_ID AGENT_ID",
+		Short: "List who has access to an agent",
+		Long: `List the IAM role bindings that control access to an agent.
+
+Examples:
+  gemctl engines agents access-list my-engine 1234567890
+  gemctl engines agents access-list my-engine 1234567890 --format=json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			parent := agentsParent(cmd, args[0], config)
+			agentName := client.ConstructAgentName(parent, args[1])
+
+			policy, err := geminiClient.GetAgentIamPolicy(agentName)
+			if err != nil {
+				return fmt.Errorf("failed to get agent access: %w", err)
+			}
+
+			return outputAgentAccess(client.AgentAccessEntries(policy), config.Format)
+		},
+	}
+
+	return cmd
+}
+
+func resolveAgentPrincipals(geminiClient *client.GeminiClient, users, groups, members []string, allUsers bool) ([]string, error) {
+	principals := make([]string, 0, len(users)+len(groups)+len(members)+1)
+	for _, member := range members {
+		if member = strings.TrimSpace(member); member != "" {
+			principals = append(principals, member)
+		}
+	}
+
+	if len(users) == 0 && len(groups) == 0 && !allUsers {
+		return principals, nil
+	}
+
+	workforceConfig, err := geminiClient.GetWorkforceIdentityConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		principal, err := workforceConfig.WorkforcePrincipal("user", user)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, principal)
+	}
+	for _, group := range groups {
+		principal, err := workforceConfig.WorkforcePrincipal("group", group)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, principal)
+	}
+	if allUsers {
+		principal, err := workforceConfig.WorkforcePrincipal("all", "")
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, principal)
+	}
+
+	return principals, nil
+}
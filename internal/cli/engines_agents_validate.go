@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewEnginesAgentsValidateCommand creates the engines agents validate command
+func NewEnginesAgentsValidateCommand() *cobra.Command {
+	var dialogflowAgent string
+	var dialogflowProject string
+	var dialogflowLocation string
+	var dialogflowAgentID string
+
+	cmd := &cobra.Command{
+		Use:   "validate [ENGINE_ID AGENT_ID]",
+		Short: "Validate a Dialogflow agent before or after registration",
+		Long: `Run pre-flight checks against a Dialogflow CX agent.
+
+The checks confirm that the agent exists, that its location is compatible with the engine
+location, that you can query it, and that the Discovery Engine service agent has the
+Dialogflow client role on the agent's project. Each failed check includes a suggested fix.
+
+Pass ENGINE_ID and AGENT_ID to validate an existing registration, or use the Dialogflow
+flags to validate an agent before registering it.
+
+Examples:
+  gemctl engines agents validate --dialogflow-agent=projects/df-project/locations/global/agents/1234
+  gemctl engines agents validate my-engine 1234567890`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("expected either no arguments or ENGINE_ID and AGENT_ID")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			resource, err := resolveDialogflowAgentResource(dialogflowAgent, dialogflowProject, dialogflowLocation, dialogflowAgentID)
+			if err != nil {
+				return err
+			}
+			if resource != "" && len(args) > 0 {
+				return fmt.Errorf("use either ENGINE_ID AGENT_ID or the Dialogflow flags, not both")
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			if len(args) == 2 {
+				parent := agentsParent(cmd, args[0], config)
+				agent, err := geminiClient.GetAgent(client.ConstructAgentName(parent, args[1]))
+				if err != nil {
+					return fmt.Errorf("failed to get agent: %w", err)
+				}
+				if agent == nil {
+					return fmt.Errorf("agent not found: %s", args[1])
+				}
+				if agent.DialogflowAgentDefinition == nil || agent.DialogflowAgentDefinition.DialogflowAgent == "" {
+					return fmt.Errorf("agent %s is not a Dialogflow agent", args[1])
+				}
+				resource = agent.DialogflowAgentDefinition.DialogflowAgent
+			}
+
+			if resource == "" {
+				return fmt.Errorf("provide ENGINE_ID AGENT_ID, --dialogflow-agent, or the Dialogflow project/location/agent ID flags")
+			}
+
+			report, err := geminiClient.ValidateDialogflowAgent(resource)
+			if err != nil {
+				return err
+			}
+
+			if err := outputAgentValidationReport(report, config.Format); err != nil {
+				return err
+			}
+			if report.HasFailures() {
+				return fmt.Errorf("dialogflow agent validation failed")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dialogflowAgent, "dialogflow-agent", "", "Fully qualified Dialogflow agent resource name")
+	cmd.Flags().StringVar(&dialogflowProject, "dialogflow-project-id", "", "Dialogflow agent project ID")
+	cmd.Flags().StringVar(&dialogflowLocation, "dialogflow-location", "", "Dialogflow agent location (e.g., global, us-central1)")
+	cmd.Flags().StringVar(&dialogflowAgentID, "dialogflow-agent-id", "", "Dialogflow agent ID")
+
+	return cmd
+}
+
+// preflightDialogflowAgent validates a Dialogflow agent before it is registered.
+// Non-passing checks are reported on stderr; failures abort the command.
+func preflightDialogflowAgent(geminiClient *client.GeminiClient, resource string) error {
+	report, err := geminiClient.ValidateDialogflowAgent(resource)
+	if err != nil {
+		return err
+	}
+
+	for _, check := range report.Checks {
+		if check.Status == client.ValidationPass {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s %s: %s\n", check.Status, check.Name, check.Message)
+		if check.Fix != "" {
+			fmt.Fprintf(os.Stderr, "  fix: %s\n", check.Fix)
+		}
+	}
+
+	if report.HasFailures() {
+		return fmt.Errorf("dialogflow agent pre-flight validation failed (use --skip-validation to register anyway)")
+	}
+	return nil
+}
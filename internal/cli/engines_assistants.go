@@ -0,0 +1,489 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+var webGroundingTypes = map[string]string{
+	"disabled":              "WEB_GROUNDING_TYPE_DISABLED",
+	"google-search":         "WEB_GROUNDING_TYPE_GOOGLE_SEARCH",
+	"enterprise-web-search": "WEB_GROUNDING_TYPE_ENTERPRISE_WEB_SEARCH",
+}
+
+// NewEnginesAssistantsCommand creates the engines assistants command group
+func NewEnginesAssistantsCommand() *cobra.Command {
+	assistantCmd := &cobra.Command{
+		Use:     "assistants",
+		Aliases: []string{"assistant"},
+		Short:   "Manage engine assistants and their configuration",
+		Long: `Inspect and change the engine assistants that agents are registered against:
+generation config, system instructions, web grounding, enabled tools, and customer policy.
+
+Every engine has a default assistant. Additional assistants can be created to segment
+agents, for example per business unit; describe, update and export select one with --assistant.`,
+	}
+
+	assistantCmd.AddCommand(NewEnginesAssistantsListCommand())
+	assistantCmd.AddCommand(NewEnginesAssistantsCreateCommand())
+	assistantCmd.AddCommand(NewEnginesAssistantsDeleteCommand())
+	assistantCmd.AddCommand(NewEnginesAssistantDescribeCommand())
+	assistantCmd.AddCommand(NewEnginesAssistantUpdateCommand())
+	assistantCmd.AddCommand(NewEnginesAssistantExportCommand())
+
+	return assistantCmd
+}
+
+// NewEnginesAssistantDescribeCommand shows the assistant configuration
+func NewEnginesAssistantDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe ENGINE_ID",
+		Short: "Describe the engine assistant",
+		Long: `Show the configuration of the engine assistant.
+
+Examples:
+  gemctl engines assistants describe my-engine
+  gemctl engines assistants describe my-engine --assistant=finance --format=yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			assistant, err := geminiClient.GetAssistant(agentsParent(cmd, args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to get assistant: %w", err)
+			}
+
+			return outputAssistantDetails(assistant, config.Format)
+		},
+	}
+
+	addAssistantFlag(cmd)
+	return cmd
+}
+
+// NewEnginesAssistantExportCommand writes the assistant configuration as YAML
+func NewEnginesAssistantExportCommand() *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export ENGINE_ID",
+		Short: "Export the engine assistant configuration as YAML",
+		Long: `Export the engine assistant configuration as YAML. The exported file can be edited
+and applied with 'gemctl engines assistants update --file'.
+
+Examples:
+  gemctl engines assistants export my-engine -o assistant.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			assistant, err := geminiClient.GetAssistant(agentsParent(cmd, args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to get assistant: %w", err)
+			}
+
+			data, err := yaml.Marshal(assistant)
+			if err != nil {
+				return fmt.Errorf("failed to marshal assistant: %w", err)
+			}
+
+			if outputPath == "" {
+				fmt.Print(string(data))
+				return nil
+			}
+
+			if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+				return fmt.Errorf("failed to write assistant file: %w", err)
+			}
+			fmt.Printf("Assistant configuration written to %s\n", outputPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write assistant YAML (default stdout)")
+	addAssistantFlag(cmd)
+	return cmd
+}
+
+// NewEnginesAssistantUpdateCommand updates the assistant configuration
+func NewEnginesAssistantUpdateCommand() *cobra.Command {
+	var file string
+	var displayName string
+	var description string
+	var systemInstruction string
+	var defaultLanguage string
+	var webGrounding string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "update ENGINE_ID",
+		Short: "Update the engine assistant configuration",
+		Long: `Update the engine assistant configuration from flags or from a YAML file produced by
+'gemctl engines assistants export'. Only fields that differ from the live assistant are sent.
+Fields the file leaves out keep their live values; set a field to null to clear it.
+Flags override values read from --file.
+
+Web grounding values: disabled, google-search, enterprise-web-search.
+
+Examples:
+  gemctl engines assistants update my-engine --system-instruction="Answer in a formal tone."
+  gemctl engines assistants update my-engine --assistant=finance --web-grounding=google-search
+  gemctl engines assistants update my-engine -F assistant.yaml --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			current, err := geminiClient.GetAssistant(agentsParent(cmd, args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to get assistant: %w", err)
+			}
+
+			desired := &client.Assistant{}
+			if file != "" {
+				desired, err = loadAssistantFile(file, current)
+				if err != nil {
+					return err
+				}
+			} else {
+				*desired = *current
+				desired.GenerationConfig = cloneGenerationConfig(current.GenerationConfig)
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("display-name") {
+				desired.DisplayName = displayName
+			}
+			if flags.Changed("description") {
+				desired.Description = description
+			}
+			if flags.Changed("system-instruction") || flags.Changed("default-language") {
+				if desired.GenerationConfig == nil {
+					desired.GenerationConfig = &client.AssistantGenerationConfig{}
+				}
+				if flags.Changed("system-instruction") {
+					desired.GenerationConfig.SystemInstruction = &client.AssistantSystemInstruction{
+						AdditionalSystemInstruction: systemInstruction,
+					}
+				}
+				if flags.Changed("default-language") {
+					desired.GenerationConfig.DefaultLanguage = defaultLanguage
+				}
+			}
+			if flags.Changed("web-grounding") {
+				groundingType, err := parseWebGroundingType(webGrounding)
+				if err != nil {
+					return err
+				}
+				desired.WebGroundingType = groundingType
+			}
+
+			mask := client.DiffAssistantFields(current, desired)
+			if len(mask) == 0 {
+				fmt.Println("Assistant already matches the requested configuration; nothing to do.")
+				return nil
+			}
+
+			if dryRun {
+				fmt.Printf("Fields to update: %s\n", strings.Join(mask, ", "))
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			updated, err := geminiClient.UpdateAssistant(current.Name, desired, mask)
+			if err != nil {
+				return fmt.Errorf("failed to update assistant: %w", err)
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputAssistantDetails(updated, config.Format)
+			}
+
+			fmt.Printf("Assistant updated: %s\n", strings.Join(mask, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with the assistant configuration")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Assistant display name")
+	cmd.Flags().StringVar(&description, "description", "", "Assistant description")
+	cmd.Flags().StringVar(&systemInstruction, "system-instruction", "", "Additional system instruction for generated answers")
+	cmd.Flags().StringVar(&defaultLanguage, "default-language", "", "Default answer language code (e.g. en)")
+	cmd.Flags().StringVar(&webGrounding, "web-grounding", "", "Web grounding type (disabled, google-search, enterprise-web-search)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show fields that would change")
+	addAssistantFlag(cmd)
+	return cmd
+}
+
+// NewEnginesAssistantsListCommand lists the assistants defined on an engine
+func NewEnginesAssistantsListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list ENGINE_ID",
+		Short: "List assistants defined on an engine",
+		Long: `List the default assistant and any additional assistants defined on an engine.
+
+Examples:
+  gemctl engines assistants list my-engine`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			assistants, err := geminiClient.ListAssistants(constructEngineName(args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to list assistants: %w", err)
+			}
+
+			return outputAssistants(assistants, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesAssistantsCreateCommand creates an additional assistant
+func NewEnginesAssistantsCreateCommand() *cobra.Command {
+	var file string
+	var displayName string
+	var description string
+	var systemInstruction string
+
+	cmd := &cobra.Command{
+		Use:   "create ENGINE_ID ASSISTANT_ID",
+		Short: "Create an additional assistant on an engine",
+		Long: `Create an additional assistant on an engine. Agents can then be registered against it
+with 'gemctl engines agents create --assistant=ASSISTANT_ID'.
+
+Examples:
+  gemctl engines assistants create my-engine finance --display-name="Finance Assistant"
+  gemctl engines assistants create my-engine hr -F hr-assistant.yaml`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			assistant := &client.Assistant{}
+			if file != "" {
+				assistant, err = loadAssistantFile(file, nil)
+				if err != nil {
+					return err
+				}
+			}
+			if displayName != "" {
+				assistant.DisplayName = displayName
+			}
+			if description != "" {
+				assistant.Description = description
+			}
+			if systemInstruction != "" {
+				if assistant.GenerationConfig == nil {
+					assistant.GenerationConfig = &client.AssistantGenerationConfig{}
+				}
+				assistant.GenerationConfig.SystemInstruction = &client.AssistantSystemInstruction{
+					AdditionalSystemInstruction: systemInstruction,
+				}
+			}
+			if assistant.DisplayName == "" {
+				assistant.DisplayName = args[1]
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			created, err := geminiClient.CreateAssistant(constructEngineName(args[0], config), args[1], assistant)
+			if err != nil {
+				return fmt.Errorf("failed to create assistant: %w", err)
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputAssistantDetails(created, config.Format)
+			}
+
+			fmt.Printf("Assistant created: %s\n", created.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with the assistant configuration")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Assistant display name (default: ASSISTANT_ID)")
+	cmd.Flags().StringVar(&description, "description", "", "Assistant description")
+	cmd.Flags().StringVar(&systemInstruction, "system-instruction", "", "Additional system instruction for generated answers")
+
+	return cmd
+}
+
+// NewEnginesAssistantsDeleteCommand deletes an additional assistant
+func NewEnginesAssistantsDeleteCommand() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete ENGINE_ID ASSISTANT_ID",
+		Short: "Delete an additional assistant",
+		Long: `Delete an additional assistant and the agents registered to it.
+The default assistant cannot be deleted.
+
+Examples:
+  gemctl engines assistants delete my-engine finance
+  gemctl engines assistants delete my-engine finance --force`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			assistantName := client.ConstructAssistantName(constructEngineName(args[0], config), args[1])
+
+			if !force {
+				agents, err := geminiClient.ListAgents(assistantName)
+				if err != nil {
+					return fmt.Errorf("failed to list agents: %w", err)
+				}
+				prompt := fmt.Sprintf("Delete assistant %s and its %d agent(s)? (y/N): ", args[1], len(agents))
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Delete cancelled.")
+					return nil
+				}
+			}
+
+			result, err := geminiClient.DeleteAssistant(assistantName)
+			if err != nil {
+				return fmt.Errorf("failed to delete assistant: %w", err)
+			}
+
+			fmt.Println(result.Message)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Delete without confirmation")
+
+	return cmd
+}
+
+// addAssistantFlag registers --assistant on commands that act on a single
+// existing assistant.
+func addAssistantFlag(cmd *cobra.Command) {
+	cmd.Flags().String("assistant", "", "Assistant ID to operate on (default: default_assistant)")
+}
+
+// loadAssistantFile reads an assistant YAML file. With a base assistant, the
+// fields declared in the file are laid over a copy of base.
+func loadAssistantFile(path string, base *client.Assistant) (*client.Assistant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read assistant file %s: %w", path, err)
+	}
+
+	var assistant client.Assistant
+	if err := overlayYAMLFields(data, base, &assistant); err != nil {
+		return nil, fmt.Errorf("failed to parse assistant file: %w", err)
+	}
+	return &assistant, nil
+}
+
+// overlayYAMLFields decodes base into out and then replaces every top-level
+// field the YAML document declares. Fields the document leaves out keep the
+// value from base; an explicit null clears the field.
+func overlayYAMLFields(data []byte, base interface{}, out interface{}) error {
+	if base == nil {
+		return yaml.Unmarshal(data, out)
+	}
+
+	fields := map[string]interface{}{}
+	raw, err := json.Marshal(base)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+
+	declared := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &declared); err != nil {
+		return err
+	}
+	for key, value := range declared {
+		fields[key] = value
+	}
+
+	raw, err = json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func parseWebGroundingType(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if groundingType, ok := webGroundingTypes[normalized]; ok {
+		return groundingType, nil
+	}
+	upper := strings.ToUpper(strings.TrimSpace(value))
+	for _, groundingType := range webGroundingTypes {
+		if upper == groundingType {
+			return groundingType, nil
+		}
+	}
+	return "", fmt.Errorf("unknown web grounding type %q (use disabled, google-search, or enterprise-web-search)", value)
+}
+
+func cloneGenerationConfig(src *client.AssistantGenerationConfig) *client.AssistantGenerationConfig {
+	if src == nil {
+		return nil
+	}
+	dst := *src
+	if src.SystemInstruction != nil {
+		instruction := *src.SystemInstruction
+		dst.SystemInstruction = &instruction
+	}
+	return &dst
+}
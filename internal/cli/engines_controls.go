@@ -0,0 +1,664 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// NewEnginesControlsCommand creates the engines controls command group
+func NewEnginesControlsCommand() *cobra.Command {
+	controlsCmd := &cobra.Command{
+		Use:     "controls",
+		Aliases: []string{"control"},
+		Short:   "Manage boost, filter, redirect, synonyms and promote controls",
+		Long: `Manage the serving controls of an engine. A control takes effect once it is linked
+to a serving config (by default default_search).
+
+Control types:
+  boost      Boost or bury documents matching a filter
+  filter     Restrict results to documents matching a filter
+  redirect   Send matching queries to a URI
+  synonyms   Treat a set of terms as synonyms
+  promote    Pin a link at the top of results (create from a file)
+
+Controls can be exported to YAML, edited, and imported back.`,
+	}
+
+	controlsCmd.AddCommand(NewEnginesControlsListCommand())
+	controlsCmd.AddCommand(NewEnginesControlsDescribeCommand())
+	controlsCmd.AddCommand(NewEnginesControlsCreateCommand())
+	controlsCmd.AddCommand(NewEnginesControlsUpdateCommand())
+	controlsCmd.AddCommand(NewEnginesControlsDeleteCommand())
+	controlsCmd.AddCommand(NewEnginesControlsExportCommand())
+	controlsCmd.AddCommand(NewEnginesControlsImportCommand())
+	controlsCmd.AddCommand(NewEnginesControlsLinkCommand(true))
+	controlsCmd.AddCommand(NewEnginesControlsLinkCommand(false))
+
+	return controlsCmd
+}
+
+// controlFlags holds the flags used to build a control on the command line
+type controlFlags struct {
+	displayName string
+	boost       float64
+	filter      string
+	dataStore   string
+	redirectURI string
+	synonyms    []string
+	queryTerms  []string
+	queryRegex  string
+	fullMatch   bool
+}
+
+func (f *controlFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.displayName, "display-name", "", "Control display name")
+	cmd.Flags().Float64Var(&f.boost, "boost", 0, "Boost control: boost strength from -1 (bury) to 1")
+	cmd.Flags().StringVar(&f.filter, "filter", "", "Boost or filter control: filter expression matching documents")
+	cmd.Flags().StringVar(&f.dataStore, "data-store", "", "Boost or filter control: data store the filter applies to")
+	cmd.Flags().StringVar(&f.redirectURI, "redirect-uri", "", "Redirect control: URI to send matching queries to")
+	cmd.Flags().StringSliceVar(&f.synonyms, "synonyms", nil, "Synonyms control: comma-separated synonyms")
+	cmd.Flags().StringArrayVar(&f.queryTerms, "query-term", nil, "Only apply when the query contains this term (repeatable)")
+	cmd.Flags().StringVar(&f.queryRegex, "query-regex", "", "Only apply when the query matches this regular expression")
+	cmd.Flags().BoolVar(&f.fullMatch, "full-match", false, "Query terms must match the full query")
+}
+
+// apply sets the changed flags on control. The action is taken from the
+// action flags; combining flags of different control types is an error.
+func (f *controlFlags) apply(cmd *cobra.Command, control *client.Control, config *client.Config) error {
+	flags := cmd.Flags()
+	if flags.Changed("display-name") {
+		control.DisplayName = f.displayName
+	}
+
+	dataStore := f.dataStore
+	if dataStore != "" {
+		dataStore = constructDataStoreName(dataStore, config)
+	}
+
+	actions := []string{}
+	if flags.Changed("boost") {
+		actions = append(actions, client.ControlTypeBoost)
+		action := control.BoostAction
+		if action == nil {
+			action = &client.ControlBoostAction{}
+		}
+		action.FixedBoost = f.boost
+		action.Boost = 0
+		control.BoostAction = action
+	}
+	if flags.Changed("redirect-uri") {
+		actions = append(actions, client.ControlTypeRedirect)
+		control.RedirectAction = &client.ControlRedirectAction{RedirectURI: f.redirectURI}
+	}
+	if flags.Changed("synonyms") {
+		actions = append(actions, client.ControlTypeSynonyms)
+		control.SynonymsAction = &client.ControlSynonymsAction{Synonyms: f.synonyms}
+	}
+	if len(actions) > 1 {
+		return fmt.Errorf("--%s cannot be combined with --%s", flagForControlType(actions[0]), flagForControlType(actions[1]))
+	}
+
+	if flags.Changed("filter") || flags.Changed("data-store") {
+		switch {
+		case control.BoostAction != nil:
+			if flags.Changed("filter") {
+				control.BoostAction.Filter = f.filter
+			}
+			if dataStore != "" {
+				control.BoostAction.DataStore = dataStore
+			}
+		case control.ActionType() == "" || control.FilterAction != nil:
+			if control.FilterAction == nil {
+				control.FilterAction = &client.ControlFilterAction{}
+			}
+			if flags.Changed("filter") {
+				control.FilterAction.Filter = f.filter
+			}
+			if dataStore != "" {
+				control.FilterAction.DataStore = dataStore
+			}
+		default:
+			return fmt.Errorf("--filter and --data-store only apply to boost and filter controls")
+		}
+	}
+
+	if flags.Changed("query-term") || flags.Changed("query-regex") {
+		condition := &client.ControlCondition{QueryRegex: f.queryRegex}
+		for _, term := range f.queryTerms {
+			condition.QueryTerms = append(condition.QueryTerms, &client.ControlQueryTerm{Value: term, FullMatch: f.fullMatch})
+		}
+		control.Conditions = []*client.ControlCondition{condition}
+	}
+	return nil
+}
+
+func flagForControlType(controlType string) string {
+	switch controlType {
+	case client.ControlTypeRedirect:
+		return "redirect-uri"
+	default:
+		return controlType
+	}
+}
+
+// NewEnginesControlsListCommand lists the controls of an engine
+func NewEnginesControlsListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list ENGINE_ID",
+		Short: "List controls defined on an engine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			controls, err := geminiClient.ListControls(constructEngineName(args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to list controls: %w", err)
+			}
+
+			return outputControls(controls, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesControlsDescribeCommand shows a control
+func NewEnginesControlsDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe ENGINE_ID CONTROL_ID",
+		Short: "Show a control's conditions, action and linked serving configs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			control, err := geminiClient.GetControl(client.ConstructControlName(constructEngineName(args[0], config), args[1]))
+			if err != nil {
+				return fmt.Errorf("failed to get control: %w", err)
+			}
+
+			return outputControlDetails(control, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesControlsCreateCommand creates a control
+func NewEnginesControlsCreateCommand() *cobra.Command {
+	var file string
+	var link bool
+	var servingConfigID string
+	flags := &controlFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "create ENGINE_ID CONTROL_ID",
+		Short: "Create a control from flags or a YAML file",
+		Long: `Create a control from flags or from a YAML file. Flags override values read
+from --file. Use --link to link the new control to a serving config right away.
+
+Examples:
+  gemctl engines controls create my-engine boost-handbook --display-name="Boost handbook" \
+    --boost=0.5 --filter='category: ANY("handbook")' --data-store=hr-docs --link
+  gemctl engines controls create my-engine pto-synonyms --display-name="PTO" --synonyms=pto,vacation,leave
+  gemctl engines controls create my-engine benefits-redirect --display-name="Benefits" \
+    --redirect-uri=https://benefits.example.com --query-term=benefits --full-match
+  gemctl engines controls create my-engine promote-intranet -F promote.yaml`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			control := &client.Control{}
+			if file != "" {
+				controls, err := client.LoadControlsFile(file)
+				if err != nil {
+					return err
+				}
+				if len(controls) != 1 {
+					return fmt.Errorf("%s defines %d controls; use 'gemctl engines controls import' for multiple controls", file, len(controls))
+				}
+				control = controls[0]
+			}
+			control.ID = args[1]
+			if err := flags.apply(cmd, control, config); err != nil {
+				return err
+			}
+			if control.DisplayName == "" {
+				control.DisplayName = args[1]
+			}
+			if err := control.Validate(); err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			engineName := constructEngineName(args[0], config)
+			created, err := geminiClient.CreateControl(engineName, control)
+			if err != nil {
+				return err
+			}
+
+			if link {
+				created.ID = args[1]
+				if _, err := geminiClient.LinkControl(client.ConstructServingConfigName(engineName, servingConfigID), created); err != nil {
+					return fmt.Errorf("control created but not linked: %w", err)
+				}
+				created, err = geminiClient.GetControl(created.Name)
+				if err != nil {
+					return err
+				}
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputControlDetails(created, config.Format)
+			}
+			fmt.Printf("✅ Created %s control %s\n", created.ActionType(), args[1])
+			if link {
+				fmt.Printf("Linked to serving config %s\n", extractResourceID(client.ConstructServingConfigName(engineName, servingConfigID)))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with the control definition")
+	cmd.Flags().BoolVar(&link, "link", false, "Link the control to a serving config after creating it")
+	cmd.Flags().StringVar(&servingConfigID, "serving-config", "", "Serving config to link to with --link (default: default_search)")
+	flags.register(cmd)
+
+	return cmd
+}
+
+// NewEnginesControlsUpdateCommand updates a control
+func NewEnginesControlsUpdateCommand() *cobra.Command {
+	var file string
+	var dryRun bool
+	flags := &controlFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "update ENGINE_ID CONTROL_ID",
+		Short: "Update a control's conditions or action",
+		Long: `Update a control from flags or from a YAML file. Only fields that differ from the
+live control are sent. A control's type cannot be changed; delete and recreate it instead.
+
+Examples:
+  gemctl engines controls update my-engine boost-handbook --boost=0.8
+  gemctl engines controls update my-engine pto-synonyms --synonyms=pto,vacation,leave,holiday
+  gemctl engines controls update my-engine promote-intranet -F promote.yaml --dry-run`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			name := client.ConstructControlName(constructEngineName(args[0], config), args[1])
+			current, err := geminiClient.GetControl(name)
+			if err != nil {
+				return fmt.Errorf("failed to get control: %w", err)
+			}
+
+			var desired *client.Control
+			if file != "" {
+				controls, err := client.LoadControlsFile(file)
+				if err != nil {
+					return err
+				}
+				if len(controls) != 1 {
+					return fmt.Errorf("%s defines %d controls; use 'gemctl engines controls import' for multiple controls", file, len(controls))
+				}
+				desired = controls[0]
+				desired.ID = args[1]
+			} else {
+				desired = current.ExportForm()
+			}
+			if err := flags.apply(cmd, desired, config); err != nil {
+				return err
+			}
+			if desired.ActionType() != current.ActionType() {
+				return fmt.Errorf("control %s cannot change from a %s to a %s control; delete it and create it again",
+					args[1], current.ActionType(), desired.ActionType())
+			}
+
+			mask := client.DiffControlFields(current, desired)
+			if len(mask) == 0 {
+				fmt.Println("Control already matches the requested configuration; nothing to do.")
+				return nil
+			}
+
+			if dryRun {
+				fmt.Printf("Fields to update: %s\n", strings.Join(mask, ", "))
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			updated, err := geminiClient.UpdateControl(name, desired, mask)
+			if err != nil {
+				return err
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputControlDetails(updated, config.Format)
+			}
+			fmt.Printf("Control updated: %s\n", strings.Join(mask, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with the control definition")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show fields that would change")
+	flags.register(cmd)
+
+	return cmd
+}
+
+// NewEnginesControlsDeleteCommand deletes a control
+func NewEnginesControlsDeleteCommand() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete ENGINE_ID CONTROL_ID",
+		Short: "Delete a control, unlinking it from serving configs first",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			name := client.ConstructControlName(constructEngineName(args[0], config), args[1])
+			control, err := geminiClient.GetControl(name)
+			if err != nil {
+				return fmt.Errorf("failed to get control: %w", err)
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Delete %s control %s? (y/N): ", control.ActionType(), args[1])
+				if len(control.AssociatedServingConfigIds) > 0 {
+					prompt = fmt.Sprintf("Delete %s control %s and unlink it from %s? (y/N): ",
+						control.ActionType(), args[1], strings.Join(control.AssociatedServingConfigIds, ", "))
+				}
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Deletion cancelled.")
+					return nil
+				}
+			}
+
+			if err := geminiClient.DeleteControl(name, true); err != nil {
+				return err
+			}
+
+			fmt.Printf("✅ Deleted control %s\n", args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewEnginesControlsExportCommand writes all controls of an engine as YAML
+func NewEnginesControlsExportCommand() *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export ENGINE_ID",
+		Short: "Export all controls of an engine as YAML",
+		Long: `Export all controls of an engine as a YAML controls file. The file can be edited
+and applied with 'gemctl engines controls import'.
+
+Examples:
+  gemctl engines controls export my-engine -o controls.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			controls, err := geminiClient.ListControls(constructEngineName(args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to list controls: %w", err)
+			}
+
+			file := client.ControlsFile{Controls: make([]*client.Control, 0, len(controls))}
+			for _, control := range controls {
+				file.Controls = append(file.Controls, control.ExportForm())
+			}
+
+			data, err := yaml.Marshal(file)
+			if err != nil {
+				return fmt.Errorf("failed to marshal controls: %w", err)
+			}
+
+			if outputPath == "" {
+				fmt.Print(string(data))
+				return nil
+			}
+
+			if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+				return fmt.Errorf("failed to write controls file: %w", err)
+			}
+			fmt.Printf("%d control(s) written to %s\n", len(file.Controls), outputPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write controls YAML (default stdout)")
+
+	return cmd
+}
+
+// NewEnginesControlsImportCommand creates and updates controls from a YAML file
+func NewEnginesControlsImportCommand() *cobra.Command {
+	var file string
+	var prune bool
+	var dryRun bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "import ENGINE_ID --file FILE",
+		Short: "Create and update controls from a YAML controls file",
+		Long: `Create controls that are missing on the engine and update those that differ from
+a YAML controls file (as written by 'gemctl engines controls export'). With --prune,
+controls not in the file are unlinked and deleted.
+
+  controls:
+    - id: boost-handbook
+      displayName: Boost handbook
+      boostAction:
+        fixedBoost: 0.5
+        filter: 'category: ANY("handbook")'
+        dataStore: projects/my-project/locations/global/collections/default_collection/dataStores/hr-docs
+    - id: pto-synonyms
+      displayName: PTO
+      synonymsAction:
+        synonyms: [pto, vacation, leave]
+
+Linking controls to serving configs is not changed by import; use
+'gemctl engines controls link' or 'gemctl engines serving-configs update'.
+
+Examples:
+  gemctl engines controls import my-engine -F controls.yaml --dry-run
+  gemctl engines controls import my-engine -F controls.yaml --prune --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			desired, err := client.LoadControlsFile(file)
+			if err != nil {
+				return err
+			}
+			for _, control := range desired {
+				if err := control.Validate(); err != nil {
+					return err
+				}
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			engineName := constructEngineName(args[0], config)
+			current, err := geminiClient.ListControls(engineName)
+			if err != nil {
+				return fmt.Errorf("failed to list controls: %w", err)
+			}
+
+			changes := client.DiffControls(current, desired, prune)
+			if len(changes) == 0 {
+				fmt.Println("Controls already match the file; nothing to do.")
+				return nil
+			}
+
+			if err := outputControlChanges(changes, config.Format); err != nil {
+				return err
+			}
+			if dryRun {
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			if prune && !force {
+				if proceed, err := promptForConfirmation("Apply these changes, including deletions? (y/N): "); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Import cancelled.")
+					return nil
+				}
+			}
+
+			if _, err := geminiClient.SyncControls(engineName, current, desired); err != nil {
+				return err
+			}
+			if prune {
+				if _, err := geminiClient.PruneControls(engineName, current, desired); err != nil {
+					return err
+				}
+			}
+
+			fmt.Printf("✅ Imported %d control(s) from %s\n", len(desired), file)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML controls file (required)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete controls that are not in the file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be made")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt for --prune")
+
+	return cmd
+}
+
+// NewEnginesControlsLinkCommand creates the controls link or unlink command
+func NewEnginesControlsLinkCommand(link bool) *cobra.Command {
+	var servingConfigID string
+
+	use, short, done := "unlink", "Unlink a control from a serving config", "unlinked from"
+	if link {
+		use, short, done = "link", "Link a control to a serving config", "linked to"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " ENGINE_ID CONTROL_ID",
+		Short: short,
+		Long: short + `. The control is added to or removed from the serving
+config's list for its type (boostControlIds, filterControlIds, ...).
+
+Examples:
+  gemctl engines controls ` + use + ` my-engine boost-handbook
+  gemctl engines controls ` + use + ` my-engine boost-handbook --serving-config=internal_search`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			engineName := constructEngineName(args[0], config)
+			control, err := geminiClient.GetControl(client.ConstructControlName(engineName, args[1]))
+			if err != nil {
+				return fmt.Errorf("failed to get control: %w", err)
+			}
+
+			name := client.ConstructServingConfigName(engineName, servingConfigID)
+			var servingConfig *client.ServingConfig
+			if link {
+				servingConfig, err = geminiClient.LinkControl(name, control)
+			} else {
+				servingConfig, err = geminiClient.UnlinkControl(name, control)
+			}
+			if err != nil {
+				return err
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputServingConfigDetails(servingConfig, config.Format)
+			}
+			fmt.Printf("✅ Control %s %s serving config %s\n", args[1], done, extractResourceID(name))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&servingConfigID, "serving-config", "", "Serving config ID (default: default_search)")
+
+	return cmd
+}
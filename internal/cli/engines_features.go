@@ -2,34 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/vb140772/gemctl-go/internal/client"
 )
 
-var knownFeatures = map[string]struct{}{
-	"*":                                    {},
-	"agent-gallery":                        {},
-	"no-code-agent-builder":                {},
-	"prompt-gallery":                       {},
-	"model-selector":                       {},
-	"notebook-lm":                          {},
-	"people-search":                        {},
-	"people-search-org-chart":              {},
-	"bi-directional-audio":                 {},
-	"feedback":                             {},
-	"session-sharing":                      {},
-	"personalization-memory":               {},
-	"disable-agent-sharing":                {},
-	"disable-image-generation":             {},
-	"disable-video-generation":             {},
-	"disable-onedrive-upload":              {},
-	"disable-talk-to-content":              {},
-	"disable-google-drive-upload":          {},
-	"agent-sharing-without-admin-approval": {},
-}
-
 // NewEnginesFeaturesCommand creates the engines features command group
 func NewEnginesFeaturesCommand() *cobra.Command {
 	featuresCmd := &cobra.Command{
@@ -37,10 +16,14 @@ func NewEnginesFeaturesCommand() *cobra.Command {
 		Short: "Manage engine feature flags",
 		Long: `Manage Gemini Enterprise engine feature flags such as agent-gallery or prompt-gallery.
 
-Use subcommands to list feature states or toggle features on and off.`,
+Use subcommands to list feature states or toggle features on and off. Feature names are
+checked against gemctl's built-in feature catalog; see 'gemctl engines features catalog'.`,
 	}
 
 	featuresCmd.AddCommand(NewEnginesFeaturesListCommand())
+	featuresCmd.AddCommand(NewEnginesFeaturesCatalogCommand())
+	featuresCmd.AddCommand(NewEnginesFeaturesApplyProfileCommand())
+	featuresCmd.AddCommand(NewEnginesFeaturesComplianceCommand())
 	featuresCmd.AddCommand(NewEnginesFeaturesEnableCommand())
 	featuresCmd.AddCommand(NewEnginesFeaturesDisableCommand())
 
@@ -90,11 +73,17 @@ Examples:
 
 // NewEnginesFeaturesEnableCommand enables one or more features
 func NewEnginesFeaturesEnableCommand() *cobra.Command {
+	var allowUnknown bool
+
 	cmd := &cobra.Command{
 		Use:   "enable ENGINE_ID FEATURE [FEATURE...]",
 		Short: "Enable features for an engine",
 		Long: `Enable one or more feature flags for an engine.
 
+Unknown feature names are rejected with suggestions unless --allow-unknown is set.
+A warning is printed when the resulting states break a catalog rule, for example
+when a feature and its inverse are both ON.
+
 Examples:
   gemctl engines features enable my-engine agent-gallery prompt-gallery
   gemctl engines features enable agent-gallery my-engine`,
@@ -110,26 +99,31 @@ Examples:
 				return err
 			}
 
-			updates := make(map[string]string, len(features))
-			for _, feature := range features {
-				featureKey := normalizeFeatureKey(feature)
-				updates[featureKey] = client.EngineFeatureStateOn
+			updates, err := buildFeatureUpdates(features, client.EngineFeatureStateOn, allowUnknown)
+			if err != nil {
+				return err
 			}
 
 			return applyFeatureUpdates(config, engineID, updates)
 		},
 	}
 
+	cmd.Flags().BoolVar(&allowUnknown, "allow-unknown", false, "Allow features that are not in the feature catalog")
+
 	return cmd
 }
 
 // NewEnginesFeaturesDisableCommand disables one or more features
 func NewEnginesFeaturesDisableCommand() *cobra.Command {
+	var allowUnknown bool
+
 	cmd := &cobra.Command{
 		Use:   "disable ENGINE_ID FEATURE [FEATURE...]",
 		Short: "Disable features for an engine",
 		Long: `Disable one or more feature flags for an engine.
 
+Unknown feature names are rejected with suggestions unless --allow-unknown is set.
+
 Examples:
   gemctl engines features disable my-engine agent-gallery
   gemctl engines features disable agent-gallery my-engine`,
@@ -145,19 +139,58 @@ Examples:
 				return err
 			}
 
-			updates := make(map[string]string, len(features))
-			for _, feature := range features {
-				featureKey := normalizeFeatureKey(feature)
-				updates[featureKey] = client.EngineFeatureStateOff
+			updates, err := buildFeatureUpdates(features, client.EngineFeatureStateOff, allowUnknown)
+			if err != nil {
+				return err
 			}
 
 			return applyFeatureUpdates(config, engineID, updates)
 		},
 	}
 
+	cmd.Flags().BoolVar(&allowUnknown, "allow-unknown", false, "Allow features that are not in the feature catalog")
+
+	return cmd
+}
+
+// NewEnginesFeaturesCatalogCommand shows the built-in feature catalog
+func NewEnginesFeaturesCatalogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "Show the built-in feature catalog",
+		Long: `Show every feature flag known to gemctl with its description, default state,
+inverse feature, and the features it requires.
+
+Examples:
+  gemctl engines features catalog
+  gemctl engines features catalog --format=yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			return outputFeatureCatalog(client.FeatureCatalog(), format)
+		},
+	}
+
 	return cmd
 }
 
+func buildFeatureUpdates(features []string, state string, allowUnknown bool) (map[string]string, error) {
+	updates := make(map[string]string, len(features))
+	for _, feature := range features {
+		featureKey := normalizeFeatureKey(feature)
+		if !allowUnknown {
+			if err := client.ValidateFeatureKey(featureKey); err != nil {
+				return nil, err
+			}
+		}
+		updates[featureKey] = state
+	}
+	return updates, nil
+}
+
 func applyFeatureUpdates(config *client.Config, engineID string, updates map[string]string) error {
 	geminiClient, err := client.NewGeminiClient(config)
 	if err != nil {
@@ -165,6 +198,12 @@ func applyFeatureUpdates(config *client.Config, engineID string, updates map[str
 	}
 
 	engineName := constructEngineName(engineID, config)
+	current, err := geminiClient.GetEngineDetails(engineName)
+	if err != nil {
+		return fmt.Errorf("failed to get engine details: %w", err)
+	}
+	warnFeatureConflicts(current, updates)
+
 	updatedEngine, err := geminiClient.UpdateEngineFeatures(engineName, updates)
 	if err != nil {
 		return err
@@ -204,6 +243,34 @@ func looksLikeFeature(value string) bool {
 		return false
 	}
 
-	_, ok := knownFeatures[key]
+	_, ok := client.LookupFeature(key)
 	return ok
 }
+
+// warnFeatureConflicts prints catalog rule violations introduced by updates.
+func warnFeatureConflicts(engine *client.Engine, updates map[string]string) {
+	before := map[string]string{}
+	if engine != nil {
+		for key, value := range engine.Features {
+			before[key] = value
+		}
+	}
+	after := make(map[string]string, len(before)+len(updates))
+	for key, value := range before {
+		after[key] = value
+	}
+	for key, value := range updates {
+		after[key] = value
+	}
+
+	existing := map[string]struct{}{}
+	for _, conflict := range client.CheckFeatureConflicts(before) {
+		existing[conflict.Message] = struct{}{}
+	}
+	for _, conflict := range client.CheckFeatureConflicts(after) {
+		if _, ok := existing[conflict.Message]; ok {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", conflict.Message)
+	}
+}
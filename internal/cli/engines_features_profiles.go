@@ -0,0 +1,319 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// featureProfilePlan captures the planned feature changes for one engine.
+type featureProfilePlan struct {
+	Engine  string               `json:"engine"`
+	Changes []client.FeatureDiff `json:"changes,omitempty"`
+	Status  string               `json:"status"`
+	Error   string               `json:"error,omitempty"`
+}
+
+// NewEnginesFeaturesApplyProfileCommand applies a feature profile to engines
+func NewEnginesFeaturesApplyProfileCommand() *cobra.Command {
+	var profilesPath string
+	var engineIDs []string
+	var allEngines bool
+	var dryRun bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "apply-profile PROFILE",
+		Short: "Apply a feature profile to one or more engines",
+		Long: `Apply a named feature profile to one or more engines. A per-engine preview of the
+feature changes is shown before anything is updated. Engines are compared on effective
+feature states (explicit state, then "*", then the catalog default), as in
+'gemctl engines features compliance'; engines that need changes get every profile
+feature set explicitly.
+
+The built-in "locked-down" profile disables image and video generation, OneDrive and
+Google Drive uploads, and agent sharing. Additional profiles and engine assignments
+can be defined in a YAML file:
+
+  profiles:
+    locked-down:
+      description: Regulated business units
+      features:
+        disable-image-generation: on
+        disable-agent-sharing: on
+    open:
+      features:
+        agent-gallery: on
+        session-sharing: on
+  assignments:
+    finance-engine: locked-down
+    marketing-engine: open
+
+Examples:
+  gemctl engines features apply-profile locked-down --engines e1,e2 --dry-run
+  gemctl engines features apply-profile open --profiles profiles.yaml --all --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if allEngines == (len(engineIDs) > 0) {
+				return fmt.Errorf("provide exactly one of --engines or --all")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			profiles, err := client.LoadFeatureProfiles(profilesPath)
+			if err != nil {
+				return err
+			}
+			profile, err := profiles.Profile(args[0])
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			engines, err := resolveProfileEngines(geminiClient, config, engineIDs, allEngines)
+			if err != nil {
+				return err
+			}
+
+			plans := make([]featureProfilePlan, 0, len(engines))
+			pending := 0
+			for _, engine := range engines {
+				plan := featureProfilePlan{Engine: engine.Name, Status: "compliant"}
+				plan.Changes = client.PlanFeatureProfile(engine, profile)
+				if len(plan.Changes) > 0 {
+					plan.Status = "planned"
+					pending++
+				}
+				plans = append(plans, plan)
+			}
+
+			if pending == 0 {
+				fmt.Printf("All %d engine(s) already match profile %s; nothing to do.\n", len(plans), profile.Name)
+				return nil
+			}
+
+			if dryRun || !force {
+				if err := outputFeatureProfilePlans(plans, config.Format); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Apply profile %s to %d engine(s)? (y/N): ", profile.Name, pending)
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Apply cancelled.")
+					return nil
+				}
+			}
+
+			failed := 0
+			for i := range plans {
+				if plans[i].Status != "planned" {
+					continue
+				}
+				if _, err := geminiClient.UpdateEngineFeatures(plans[i].Engine, profile.Features); err != nil {
+					plans[i].Status = "error"
+					plans[i].Error = err.Error()
+					failed++
+					continue
+				}
+				plans[i].Status = "applied"
+			}
+
+			if err := outputFeatureProfilePlans(plans, config.Format); err != nil {
+				return err
+			}
+			if failed > 0 {
+				return fmt.Errorf("failed to apply profile to %d engine(s)", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profilesPath, "profiles", "", "YAML file with feature profiles (built-in profiles are always available)")
+	cmd.Flags().StringSliceVar(&engineIDs, "engines", nil, "Comma-separated engine IDs to update")
+	cmd.Flags().BoolVar(&allEngines, "all", false, "Apply the profile to every engine in the collection")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show planned changes")
+	cmd.Flags().BoolVar(&force, "force", false, "Apply changes without confirmation")
+
+	return cmd
+}
+
+// NewEnginesFeaturesComplianceCommand reports engines that deviate from their profile
+func NewEnginesFeaturesComplianceCommand() *cobra.Command {
+	var profilesPath string
+	var profileName string
+	var engineIDs []string
+	var allEngines bool
+	var reportPath string
+	var failOnDeviation bool
+
+	cmd := &cobra.Command{
+		Use:   "compliance",
+		Short: "Report engines that deviate from their feature profile",
+		Long: `Compare engine feature states with their assigned feature profile.
+
+By default the engine assignments in the --profiles file are checked. Use --profile with
+--engines or --all to check engines against a single profile instead.
+
+Examples:
+  gemctl engines features compliance --profiles profiles.yaml --report compliance.json
+  gemctl engines features compliance --profile locked-down --all --fail-on-deviation`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			profiles, err := client.LoadFeatureProfiles(profilesPath)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			assignments := map[string]string{}
+			var engines []*client.Engine
+			switch {
+			case profileName != "":
+				if _, err := profiles.Profile(profileName); err != nil {
+					return err
+				}
+				if allEngines == (len(engineIDs) > 0) {
+					return fmt.Errorf("--profile requires exactly one of --engines or --all")
+				}
+				engines, err = resolveProfileEngines(geminiClient, config, engineIDs, allEngines)
+				if err != nil {
+					return err
+				}
+				for _, engine := range engines {
+					assignments[engine.Name] = profileName
+				}
+			case len(profiles.Assignments) > 0:
+				ids := make([]string, 0, len(profiles.Assignments))
+				for engineID := range profiles.Assignments {
+					ids = append(ids, engineID)
+				}
+				sort.Strings(ids)
+				for _, engineID := range ids {
+					assignments[constructEngineName(engineID, config)] = profiles.Assignments[engineID]
+				}
+			default:
+				return fmt.Errorf("no engine assignments found; use --profiles with an assignments section or --profile")
+			}
+
+			report := &client.FeatureComplianceReport{
+				GeneratedAt:    time.Now().UTC().Format(time.RFC3339),
+				CatalogVersion: client.FeatureCatalogVersion,
+			}
+
+			known := map[string]*client.Engine{}
+			for _, engine := range engines {
+				known[engine.Name] = engine
+			}
+
+			names := make([]string, 0, len(assignments))
+			for name := range assignments {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, engineName := range names {
+				profile, _ := profiles.Profile(assignments[engineName])
+				entry := client.FeatureComplianceEntry{Engine: engineName, Profile: profile.Name}
+
+				engine := known[engineName]
+				if engine == nil {
+					engine, err = geminiClient.GetEngineDetails(engineName)
+					if err != nil {
+						entry.Error = err.Error()
+						report.Entries = append(report.Entries, entry)
+						continue
+					}
+				}
+
+				entry.Deviations = client.FeatureProfileDeviations(engine, profile)
+				entry.Compliant = len(entry.Deviations) == 0
+				report.Entries = append(report.Entries, entry)
+			}
+
+			if reportPath != "" {
+				data, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to encode compliance report: %w", err)
+				}
+				if err := os.WriteFile(reportPath, data, 0o644); err != nil {
+					return fmt.Errorf("failed to write compliance report: %w", err)
+				}
+			}
+
+			if err := outputFeatureComplianceReport(report, config.Format); err != nil {
+				return err
+			}
+			if reportPath != "" && config.Format != "json" && config.Format != "yaml" {
+				fmt.Printf("Compliance report written to %s\n", reportPath)
+			}
+
+			if failOnDeviation && report.DeviatingEngines() > 0 {
+				return fmt.Errorf("%d engine(s) deviate from their feature profile", report.DeviatingEngines())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profilesPath, "profiles", "", "YAML file with feature profiles and engine assignments")
+	cmd.Flags().StringVar(&profileName, "profile", "", "Check engines against this profile instead of the file assignments")
+	cmd.Flags().StringSliceVar(&engineIDs, "engines", nil, "Comma-separated engine IDs to check (with --profile)")
+	cmd.Flags().BoolVar(&allEngines, "all", false, "Check every engine in the collection (with --profile)")
+	cmd.Flags().StringVar(&reportPath, "report", "", "Write the compliance report as JSON to this path")
+	cmd.Flags().BoolVar(&failOnDeviation, "fail-on-deviation", false, "Exit with an error when any engine deviates")
+
+	return cmd
+}
+
+func resolveProfileEngines(geminiClient *client.GeminiClient, config *client.Config, engineIDs []string, allEngines bool) ([]*client.Engine, error) {
+	if allEngines {
+		engines, err := geminiClient.ListEngines(config.Collection)
+		if err != nil {
+			return nil, err
+		}
+		if len(engines) == 0 {
+			return nil, fmt.Errorf("no engines found in collection %s", config.Collection)
+		}
+		return engines, nil
+	}
+
+	engines := make([]*client.Engine, 0, len(engineIDs))
+	for _, engineID := range engineIDs {
+		engine, err := geminiClient.GetEngineDetails(constructEngineName(engineID, config))
+		if err != nil {
+			return nil, fmt.Errorf("engine %s: %w", engineID, err)
+		}
+		engines = append(engines, engine)
+	}
+	return engines, nil
+}
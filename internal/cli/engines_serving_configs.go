@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// NewEnginesServingConfigsCommand creates the engines serving-configs command group
+func NewEnginesServingConfigsCommand() *cobra.Command {
+	servingConfigCmd := &cobra.Command{
+		Use:     "serving-configs",
+		Aliases: []string{"serving-config"},
+		Short:   "Manage engine serving configs and the controls linked to them",
+		Long: `Inspect and change the serving configs of an engine: ranking, diversity, answer
+generation, and the boost, filter, redirect, synonyms and promote controls they apply.
+
+Every search engine has a default_search serving config; select another with
+--serving-config. Controls are managed with 'gemctl engines controls'.`,
+	}
+
+	servingConfigCmd.PersistentFlags().String("serving-config", "", "Serving config ID to operate on (default: default_search)")
+
+	servingConfigCmd.AddCommand(NewEnginesServingConfigsListCommand())
+	servingConfigCmd.AddCommand(NewEnginesServingConfigsDescribeCommand())
+	servingConfigCmd.AddCommand(NewEnginesServingConfigsUpdateCommand())
+	servingConfigCmd.AddCommand(NewEnginesServingConfigsExportCommand())
+
+	return servingConfigCmd
+}
+
+// servingConfigName resolves the --serving-config flag against an engine
+func servingConfigName(cmd *cobra.Command, engineID string, config *client.Config) string {
+	servingConfigID, _ := cmd.Flags().GetString("serving-config")
+	return client.ConstructServingConfigName(constructEngineName(engineID, config), servingConfigID)
+}
+
+// NewEnginesServingConfigsListCommand lists the serving configs of an engine
+func NewEnginesServingConfigsListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list ENGINE_ID",
+		Short: "List serving configs of an engine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			servingConfigs, err := geminiClient.ListServingConfigs(constructEngineName(args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to list serving configs: %w", err)
+			}
+
+			return outputServingConfigs(servingConfigs, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesServingConfigsDescribeCommand shows a serving config
+func NewEnginesServingConfigsDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe ENGINE_ID",
+		Short: "Show a serving config and its linked controls",
+		Long: `Show a serving config, including its ranking settings and linked controls.
+
+Examples:
+  gemctl engines serving-configs describe my-engine
+  gemctl engines serving-configs describe my-engine --serving-config=internal_search --format=yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			servingConfig, err := geminiClient.GetServingConfig(servingConfigName(cmd, args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to get serving config: %w", err)
+			}
+
+			return outputServingConfigDetails(servingConfig, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+// NewEnginesServingConfigsExportCommand writes a serving config as YAML
+func NewEnginesServingConfigsExportCommand() *cobra.Command {
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export ENGINE_ID",
+		Short: "Export a serving config as YAML",
+		Long: `Export a serving config as YAML. The exported file can be edited and applied with
+'gemctl engines serving-configs update --file'.
+
+Examples:
+  gemctl engines serving-configs export my-engine -o serving-config.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			servingConfig, err := geminiClient.GetServingConfig(servingConfigName(cmd, args[0], config))
+			if err != nil {
+				return fmt.Errorf("failed to get serving config: %w", err)
+			}
+			servingConfig.CreateTime = ""
+			servingConfig.UpdateTime = ""
+
+			data, err := yaml.Marshal(servingConfig)
+			if err != nil {
+				return fmt.Errorf("failed to marshal serving config: %w", err)
+			}
+
+			if outputPath == "" {
+				fmt.Print(string(data))
+				return nil
+			}
+
+			if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+				return fmt.Errorf("failed to write serving config file: %w", err)
+			}
+			fmt.Printf("Serving config written to %s\n", outputPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write serving config YAML (default stdout)")
+
+	return cmd
+}
+
+// NewEnginesServingConfigsUpdateCommand updates a serving config
+func NewEnginesServingConfigsUpdateCommand() *cobra.Command {
+	var file string
+	var displayName string
+	var rankingExpression string
+	var diversityLevel string
+	var modelID string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "update ENGINE_ID",
+		Short: "Update a serving config",
+		Long: `Update a serving config from flags or from a YAML file produced by
+'gemctl engines serving-configs export'. Only fields that differ from the live serving
+config are sent. Fields the file leaves out keep their live values; set a field to null to
+clear it. Flags override values read from --file.
+
+Linked controls can be edited in the file (boostControlIds, filterControlIds, ...) or
+with 'gemctl engines controls link/unlink'.
+
+Examples:
+  gemctl engines serving-configs update my-engine --diversity-level=medium
+  gemctl engines serving-configs update my-engine -F serving-config.yaml --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			name := servingConfigName(cmd, args[0], config)
+			current, err := geminiClient.GetServingConfig(name)
+			if err != nil {
+				return fmt.Errorf("failed to get serving config: %w", err)
+			}
+
+			desired := &client.ServingConfig{}
+			if file != "" {
+				desired, err = loadServingConfigFile(file, current)
+				if err != nil {
+					return err
+				}
+			} else {
+				*desired = *current
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("display-name") {
+				desired.DisplayName = displayName
+			}
+			if flags.Changed("ranking-expression") {
+				desired.RankingExpression = rankingExpression
+			}
+			if flags.Changed("diversity-level") {
+				desired.DiversityLevel = diversityLevel
+			}
+			if flags.Changed("model-id") {
+				desired.ModelID = modelID
+			}
+
+			mask := client.DiffServingConfigFields(current, desired)
+			if len(mask) == 0 {
+				fmt.Println("Serving config already matches the requested configuration; nothing to do.")
+				return nil
+			}
+
+			if dryRun {
+				fmt.Printf("Fields to update: %s\n", strings.Join(mask, ", "))
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			updated, err := geminiClient.UpdateServingConfig(name, desired, mask)
+			if err != nil {
+				return err
+			}
+
+			if config.Format == "json" || config.Format == "yaml" {
+				return outputServingConfigDetails(updated, config.Format)
+			}
+
+			fmt.Printf("Serving config updated: %s\n", strings.Join(mask, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "YAML file with the serving config")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Serving config display name")
+	cmd.Flags().StringVar(&rankingExpression, "ranking-expression", "", "Custom ranking expression")
+	cmd.Flags().StringVar(&diversityLevel, "diversity-level", "", "Result diversity level (no-diversity, low, medium, high, auto)")
+	cmd.Flags().StringVar(&modelID, "model-id", "", "Model ID used for serving")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show fields that would change")
+
+	return cmd
+}
+
+// loadServingConfigFile reads a serving config YAML file and lays the fields it
+// declares over a copy of base.
+func loadServingConfigFile(path string, base *client.ServingConfig) (*client.ServingConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read serving config file %s: %w", path, err)
+	}
+
+	var servingConfig client.ServingConfig
+	if err := overlayYAMLFields(data, base, &servingConfig); err != nil {
+		return nil, fmt.Errorf("failed to parse serving config file: %w", err)
+	}
+	return &servingConfig, nil
+}
@@ -0,0 +1,367 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewEnginesSessionsCommand creates the engines sessions command group
+func NewEnginesSessionsCommand() *cobra.Command {
+	sessionsCmd := &cobra.Command{
+		Use:     "sessions",
+		Aliases: []string{"session"},
+		Short:   "Inspect, export and delete conversation sessions",
+		Long: `Manage the conversation sessions users have with an engine. Sessions hold the
+queries of each turn and references to the answers the engine gave.
+
+Sessions can be filtered by user pseudo ID and by creation time. Times are RFC 3339
+timestamps (2024-05-01T12:00:00Z), dates (2024-05-01), or durations ago (24h, 7d).`,
+	}
+
+	sessionsCmd.AddCommand(NewEnginesSessionsListCommand())
+	sessionsCmd.AddCommand(NewEnginesSessionsDescribeCommand())
+	sessionsCmd.AddCommand(NewEnginesSessionsDeleteCommand())
+	sessionsCmd.AddCommand(NewEnginesSessionsExportCommand())
+
+	return sessionsCmd
+}
+
+// sessionFilterFlags holds the flags that select sessions
+type sessionFilterFlags struct {
+	userPseudoID string
+	after        string
+	before       string
+}
+
+func (f *sessionFilterFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.userPseudoID, "user-pseudo-id", "", "Only sessions of this user pseudo ID")
+	cmd.Flags().StringVar(&f.after, "after", "", "Only sessions created after this time")
+	cmd.Flags().StringVar(&f.before, "before", "", "Only sessions created before this time")
+}
+
+func (f *sessionFilterFlags) filter() (client.SessionFilter, error) {
+	filter := client.SessionFilter{UserPseudoID: f.userPseudoID}
+	now := time.Now()
+	if f.after != "" {
+		after, err := client.ParseSessionTime(f.after, now)
+		if err != nil {
+			return filter, fmt.Errorf("--after: %w", err)
+		}
+		filter.CreatedAfter = after
+	}
+	if f.before != "" {
+		before, err := client.ParseSessionTime(f.before, now)
+		if err != nil {
+			return filter, fmt.Errorf("--before: %w", err)
+		}
+		filter.CreatedBefore = before
+	}
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return filter, fmt.Errorf("--after must be earlier than --before")
+	}
+	return filter, nil
+}
+
+// NewEnginesSessionsListCommand lists the sessions of an engine
+func NewEnginesSessionsListCommand() *cobra.Command {
+	var limit int
+	filterFlags := &sessionFilterFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "list ENGINE_ID",
+		Short: "List sessions of an engine, newest first",
+		Long: `List sessions of an engine, newest first.
+
+Examples:
+  gemctl engines sessions list my-engine
+  gemctl engines sessions list my-engine --user-pseudo-id=user-123 --after=7d
+  gemctl engines sessions list my-engine --after=2024-05-01 --before=2024-06-01 --limit=0`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := filterFlags.filter()
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sessions, err := geminiClient.ListSessions(constructEngineName(args[0], config), filter, limit)
+			if err != nil {
+				return err
+			}
+
+			return outputSessions(sessions, config.Format)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 100, "Maximum number of sessions to list (0 for all)")
+	filterFlags.register(cmd)
+
+	return cmd
+}
+
+// NewEnginesSessionsDescribeCommand shows a session and its turns
+func NewEnginesSessionsDescribeCommand() *cobra.Command {
+	var includeAnswers bool
+
+	cmd := &cobra.Command{
+		Use:   "describe ENGINE_ID SESSION_ID",
+		Short: "Show a session and its turns",
+		Long: `Show a session and the query of each turn. With --include-answers the answer
+text of each turn is included as well.
+
+Examples:
+  gemctl engines sessions describe my-engine 1234567890
+  gemctl engines sessions describe my-engine 1234567890 --include-answers --format=json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			session, err := geminiClient.GetSession(client.ConstructSessionName(constructEngineName(args[0], config), args[1]), includeAnswers)
+			if err != nil {
+				return err
+			}
+
+			return outputSessionDetails(session, config.Format)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeAnswers, "include-answers", false, "Include the answer text of each turn")
+
+	return cmd
+}
+
+// NewEnginesSessionsDeleteCommand deletes sessions by ID or by filter
+func NewEnginesSessionsDeleteCommand() *cobra.Command {
+	var all bool
+	var dryRun bool
+	var force bool
+	var concurrency int
+	filterFlags := &sessionFilterFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "delete ENGINE_ID [SESSION_ID...]",
+		Short: "Delete sessions by ID or all sessions matching a filter",
+		Long: `Delete the given sessions, or every session matching --user-pseudo-id, --after
+and --before. Deleting every session of the engine requires --all.
+
+Use --dry-run to list the sessions that would be deleted without deleting them.
+
+Examples:
+  gemctl engines sessions delete my-engine 1234567890
+  gemctl engines sessions delete my-engine --user-pseudo-id=user-123 --dry-run
+  gemctl engines sessions delete my-engine --before=90d --force
+  gemctl engines sessions delete my-engine --all`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := filterFlags.filter()
+			if err != nil {
+				return err
+			}
+
+			sessionIDs := args[1:]
+			byFilter := !filter.IsEmpty() || all
+			if len(sessionIDs) > 0 && byFilter {
+				return fmt.Errorf("session IDs cannot be combined with --all or filter flags")
+			}
+			if len(sessionIDs) == 0 && !byFilter {
+				return fmt.Errorf("provide session IDs, filter flags, or --all")
+			}
+			if all && !filter.IsEmpty() {
+				return fmt.Errorf("--all cannot be combined with filter flags")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			engineName := constructEngineName(args[0], config)
+			names := []string{}
+			if byFilter {
+				sessions, err := geminiClient.ListSessions(engineName, filter, 0)
+				if err != nil {
+					return err
+				}
+				if len(sessions) == 0 {
+					fmt.Println("No sessions match; nothing to delete.")
+					return nil
+				}
+				if dryRun {
+					if err := outputSessions(sessions, config.Format); err != nil {
+						return err
+					}
+					fmt.Printf("Dry run complete. %d session(s) would be deleted.\n", len(sessions))
+					return nil
+				}
+				for _, session := range sessions {
+					names = append(names, session.Name)
+				}
+			} else {
+				for _, id := range sessionIDs {
+					names = append(names, client.ConstructSessionName(engineName, id))
+				}
+				if dryRun {
+					for _, name := range names {
+						fmt.Println(name)
+					}
+					fmt.Printf("Dry run complete. %d session(s) would be deleted.\n", len(names))
+					return nil
+				}
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Delete %d session(s) from engine %s? (y/N): ", len(names), extractResourceID(engineName))
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Deletion cancelled.")
+					return nil
+				}
+			}
+
+			results := geminiClient.DeleteSessions(names, concurrency)
+			if err := outputSessionDeleteResults(results, config.Format); err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, result := range results {
+				if result.Status == "error" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d session deletion(s) failed", failed, len(results))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "Delete every session of the engine")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only list the sessions that would be deleted")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "Number of concurrent delete requests")
+	filterFlags.register(cmd)
+
+	return cmd
+}
+
+// NewEnginesSessionsExportCommand writes sessions as JSONL
+func NewEnginesSessionsExportCommand() *cobra.Command {
+	var outputPath string
+	var includeAnswers bool
+	var limit int
+	filterFlags := &sessionFilterFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "export ENGINE_ID",
+		Short: "Export sessions as JSONL, one session per line",
+		Long: `Export sessions matching the filter flags as JSONL, one session per line. With
+--include-answers each session is fetched with the answer text of its turns, which
+takes one request per session.
+
+Examples:
+  gemctl engines sessions export my-engine -o sessions.jsonl
+  gemctl engines sessions export my-engine --user-pseudo-id=user-123 --include-answers -o user-123.jsonl
+  gemctl engines sessions export my-engine --after=2024-05-01 --before=2024-06-01 | jq .userPseudoId`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := filterFlags.filter()
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sessions, err := geminiClient.ListSessions(constructEngineName(args[0], config), filter, limit)
+			if err != nil {
+				return err
+			}
+
+			var out io.Writer = os.Stdout
+			if outputPath != "" {
+				file, err := os.Create(outputPath)
+				if err != nil {
+					return fmt.Errorf("failed to create %s: %w", outputPath, err)
+				}
+				defer file.Close()
+				out = file
+			}
+			writer := bufio.NewWriter(out)
+
+			for i, session := range sessions {
+				if includeAnswers {
+					if outputPath != "" {
+						fmt.Fprintf(os.Stderr, "\rFetching session %d/%d...", i+1, len(sessions))
+					}
+					session, err = geminiClient.GetSession(session.Name, true)
+					if err != nil {
+						return err
+					}
+				}
+				line, err := json.Marshal(session)
+				if err != nil {
+					return fmt.Errorf("failed to marshal session: %w", err)
+				}
+				writer.Write(line)
+				writer.WriteByte('\n')
+			}
+			if err := writer.Flush(); err != nil {
+				return fmt.Errorf("failed to write sessions: %w", err)
+			}
+
+			if outputPath != "" {
+				if includeAnswers && len(sessions) > 0 {
+					fmt.Fprintln(os.Stderr)
+				}
+				fmt.Printf("%d session(s) written to %s\n", len(sessions), outputPath)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write JSONL (default stdout)")
+	cmd.Flags().BoolVar(&includeAnswers, "include-answers", false, "Include the answer text of each turn")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of sessions to export (0 for all)")
+	filterFlags.register(cmd)
+
+	return cmd
+}
@@ -17,12 +17,15 @@ func NewEnginesSnapshotCommand() *cobra.Command {
 		Long: `Manage Gemini Enterprise engine snapshots for backup, cloning, or rollback.
 
 Snapshots capture engine configuration, feature flags, and registered agents.
-They can be compared (diff) or restored to create/update engines across projects.`,
+They can be compared (diff) or restored to create/update engines across projects.
+Snapshots carry a SHA-256 content digest and can optionally be signed and redacted.`,
 	}
 
 	cmd.AddCommand(NewEnginesSnapshotCreateCommand())
 	cmd.AddCommand(NewEnginesSnapshotDiffCommand())
 	cmd.AddCommand(NewEnginesSnapshotRestoreCommand())
+	cmd.AddCommand(NewEnginesSnapshotVerifyCommand())
+	cmd.AddCommand(NewEnginesSnapshotRedactCommand())
 
 	return cmd
 }
@@ -32,6 +35,8 @@ func NewEnginesSnapshotCreateCommand() *cobra.Command {
 	var outputPath string
 	var notes string
 	var description string
+	var signKeyPath string
+	var redactRulesPath string
 
 	cmd := &cobra.Command{
 		Use:   "create ENGINE_ID",
@@ -40,7 +45,8 @@ func NewEnginesSnapshotCreateCommand() *cobra.Command {
 
 Examples:
   gemctl engines snapshot create my-engine --output=engine-snapshot.json
-  gemctl engines snapshot create my-engine --notes="Pre-deployment backup"`,
+  gemctl engines snapshot create my-engine --notes="Pre-deployment backup"
+  gemctl engines snapshot create my-engine --sign-key=snapshot-key.pem --redact-rules=redact.yaml`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config, err := getConfigFromFlags(cmd)
@@ -58,6 +64,9 @@ Examples:
 			if err != nil {
 				return fmt.Errorf("failed to create snapshot: %w", err)
 			}
+			for _, warning := range snapshot.Warnings {
+				fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+			}
 
 			if notes != "" {
 				snapshot.Metadata.Notes = notes
@@ -66,27 +75,25 @@ Examples:
 				snapshot.Metadata.Description = description
 			}
 
-			data, err := snapshot.MarshalJSONBytes()
-			if err != nil {
-				return fmt.Errorf("failed to marshal snapshot: %w", err)
-			}
-
-			if outputPath == "" {
-				fmt.Println(string(data))
-				return nil
+			if redactRulesPath != "" {
+				rules, err := client.LoadSnapshotRedactionConfig(redactRulesPath)
+				if err != nil {
+					return err
+				}
+				if err := snapshot.Redact(rules.Rules); err != nil {
+					return fmt.Errorf("failed to redact snapshot: %w", err)
+				}
 			}
 
-			if err := os.WriteFile(outputPath, data, 0o644); err != nil {
-				return fmt.Errorf("failed to write snapshot file: %w", err)
-			}
-			fmt.Printf("Snapshot written to %s\n", outputPath)
-			return nil
+			return sealAndWriteSnapshot(snapshot, signKeyPath, outputPath)
 		},
 	}
 
 	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write snapshot JSON (default stdout)")
 	cmd.Flags().StringVar(&notes, "notes", "", "Optional notes stored in snapshot metadata")
 	cmd.Flags().StringVar(&description, "description", "", "Optional description stored in snapshot metadata")
+	cmd.Flags().StringVar(&signKeyPath, "sign-key", "", "Sign the snapshot with an Ed25519 PEM private key or HMAC secret file")
+	cmd.Flags().StringVar(&redactRulesPath, "redact-rules", "", "YAML file with agent field redaction rules applied before writing")
 
 	return cmd
 }
@@ -156,6 +163,9 @@ func NewEnginesSnapshotRestoreCommand() *cobra.Command {
 	var force bool
 	var notes string
 	var updateExisting bool
+	var verifyKeyPath string
+	var requireIntegrity bool
+	var allowRedacted bool
 
 	cmd := &cobra.Command{
 		Use:   "restore SNAPSHOT_PATH [ENGINE_ID]",
@@ -170,7 +180,10 @@ Examples:
   gemctl engines snapshot restore snapshot.json my-engine
 
   # Clone snapshot into new engine
-  gemctl engines snapshot restore snapshot.json --new-engine-id cloned-engine --allow-create`,
+  gemctl engines snapshot restore snapshot.json --new-engine-id cloned-engine --allow-create
+
+  # Require a valid signature before restoring
+  gemctl engines snapshot restore snapshot.json my-engine --verify-key=snapshot-key.pub.pem`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config, err := getConfigFromFlags(cmd)
@@ -182,6 +195,11 @@ Examples:
 			if err != nil {
 				return err
 			}
+
+			if err := verifySnapshot(snapshot, verifyKeyPath, requireIntegrity); err != nil {
+				return err
+			}
+
 			if notes != "" {
 				snapshot.Metadata.Notes = notes
 			}
@@ -214,6 +232,7 @@ Examples:
 				CreateIfMissing:  allowCreate,
 				UpdateExisting:   updateExisting,
 				DryRun:           true,
+				AllowRedacted:    allowRedacted,
 			}
 
 			_, diff, err := geminiClient.RestoreEngineSnapshot(snapshot, previewOpts)
@@ -251,6 +270,7 @@ Examples:
 				CreateIfMissing:  allowCreate,
 				UpdateExisting:   updateExisting,
 				DryRun:           false,
+				AllowRedacted:    allowRedacted,
 			}
 
 			result, _, err := geminiClient.RestoreEngineSnapshot(snapshot, applyOpts)
@@ -269,6 +289,118 @@ Examples:
 	cmd.Flags().BoolVar(&force, "force", false, "Apply changes without confirmation")
 	cmd.Flags().BoolVar(&updateExisting, "update-existing", true, "Update existing engine fields when restoring")
 	cmd.Flags().StringVar(&notes, "notes", "", "Override snapshot notes before restore")
+	cmd.Flags().StringVar(&verifyKeyPath, "verify-key", "", "Verify the snapshot signature with an Ed25519 PEM public key or HMAC secret file")
+	cmd.Flags().BoolVar(&requireIntegrity, "require-integrity", false, "Refuse to restore snapshots without an integrity digest")
+	cmd.Flags().BoolVar(&allowRedacted, "allow-redacted", false, "Allow restoring snapshots whose agent fields were redacted")
+
+	return cmd
+}
+
+// NewEnginesSnapshotVerifyCommand creates the snapshot verify command.
+func NewEnginesSnapshotVerifyCommand() *cobra.Command {
+	var keyPath string
+
+	cmd := &cobra.Command{
+		Use:   "verify SNAPSHOT_PATH",
+		Short: "Verify a snapshot digest and signature",
+		Long: `Verify that a snapshot has not been modified since it was created.
+
+The content digest is always checked. When --key is provided the signature is verified as well.
+
+Examples:
+  gemctl engines snapshot verify snapshot.json
+  gemctl engines snapshot verify snapshot.json --key=snapshot-key.pub.pem`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshot, err := loadSnapshotFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := verifySnapshot(snapshot, keyPath, true); err != nil {
+				return err
+			}
+
+			fmt.Printf("Digest: %s (%s) OK\n", snapshot.Integrity.Digest, snapshot.Integrity.Algorithm)
+			if sig := snapshot.Integrity.Signature; sig != nil {
+				status := "not verified (no --key)"
+				if keyPath != "" {
+					status = "OK"
+				}
+				fmt.Printf("Signature: %s key %s %s\n", sig.Algorithm, valueOrPlaceholder(sig.KeyID), status)
+			} else {
+				fmt.Println("Signature: (unsigned)")
+			}
+			if snapshot.Metadata.Redacted {
+				fmt.Println("Redacted: yes")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&keyPath, "key", "", "Ed25519 PEM public key or HMAC secret file used to verify the signature")
+
+	return cmd
+}
+
+// NewEnginesSnapshotRedactCommand creates the snapshot redact command.
+func NewEnginesSnapshotRedactCommand() *cobra.Command {
+	var rulesPath string
+	var outputPath string
+	var signKeyPath string
+	var verifyKeyPath string
+
+	cmd := &cobra.Command{
+		Use:   "redact SNAPSHOT_PATH",
+		Short: "Redact sensitive agent fields from a snapshot",
+		Long: `Redact sensitive agent fields from an existing snapshot before sharing it.
+
+Rules are read from a YAML file with dot-separated field paths relative to each agent:
+
+  rules:
+    - field: metadata
+    - field: icon.content
+    - field: additionalAgentProperties.*
+      replacement: "<hidden>"
+    - field: labels.owner
+      remove: true
+
+The redacted snapshot is re-sealed with a new digest and optionally signed.
+
+Examples:
+  gemctl engines snapshot redact snapshot.json --rules=redact.yaml --output=shared.json
+  gemctl engines snapshot redact snapshot.json --rules=redact.yaml --sign-key=vendor.key -o shared.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rulesPath == "" {
+				return fmt.Errorf("--rules is required")
+			}
+
+			snapshot, err := loadSnapshotFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := verifySnapshot(snapshot, verifyKeyPath, false); err != nil {
+				return err
+			}
+
+			rules, err := client.LoadSnapshotRedactionConfig(rulesPath)
+			if err != nil {
+				return err
+			}
+			if err := snapshot.Redact(rules.Rules); err != nil {
+				return fmt.Errorf("failed to redact snapshot: %w", err)
+			}
+
+			return sealAndWriteSnapshot(snapshot, signKeyPath, outputPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&rulesPath, "rules", "", "YAML file with agent field redaction rules (required)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write the redacted snapshot JSON (default stdout)")
+	cmd.Flags().StringVar(&signKeyPath, "sign-key", "", "Sign the redacted snapshot with an Ed25519 PEM private key or HMAC secret file")
+	cmd.Flags().StringVar(&verifyKeyPath, "verify-key", "", "Verify the source snapshot signature before redacting")
 
 	return cmd
 }
@@ -285,6 +417,57 @@ func loadSnapshotFromFile(path string) (*client.EngineSnapshot, error) {
 	return snapshot, nil
 }
 
+// sealAndWriteSnapshot computes the snapshot digest, signs it when a key is given,
+// and writes the JSON to outputPath or stdout.
+func sealAndWriteSnapshot(snapshot *client.EngineSnapshot, signKeyPath, outputPath string) error {
+	var signer client.SnapshotSigner
+	if signKeyPath != "" {
+		loaded, err := client.LoadSnapshotSigner(signKeyPath)
+		if err != nil {
+			return err
+		}
+		signer = loaded
+	}
+
+	if err := snapshot.Seal(signer); err != nil {
+		return fmt.Errorf("failed to seal snapshot: %w", err)
+	}
+
+	data, err := snapshot.MarshalJSONBytes()
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if outputPath == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot file: %w", err)
+	}
+	fmt.Printf("Snapshot written to %s\n", outputPath)
+	return nil
+}
+
+func verifySnapshot(snapshot *client.EngineSnapshot, keyPath string, requireIntegrity bool) error {
+	var verifier client.SnapshotVerifier
+	if keyPath != "" {
+		loaded, err := client.LoadSnapshotVerifier(keyPath)
+		if err != nil {
+			return err
+		}
+		verifier = loaded
+	}
+
+	if snapshot.Integrity == nil && verifier == nil && !requireIntegrity {
+		fmt.Fprintln(os.Stderr, "Warning: snapshot has no integrity digest; it cannot be checked for tampering.")
+		return nil
+	}
+
+	return snapshot.VerifyIntegrity(verifier, requireIntegrity)
+}
+
 func promptForConfirmation(question string) (bool, error) {
 	fmt.Print(question)
 	var response string
@@ -325,5 +508,23 @@ func outputSnapshotRestoreResult(result *client.SnapshotRestoreResult) error {
 			fmt.Printf("  %s: %s\n", change.Key, change.ChangeType)
 		}
 	}
+	if len(result.AssistantChanges) > 0 {
+		fmt.Println("\nAssistant changes:")
+		for _, change := range result.AssistantChanges {
+			fmt.Printf("  %s updated\n", change.Field)
+		}
+	}
+	if len(result.ControlChanges) > 0 {
+		fmt.Println("\nControl changes:")
+		for _, change := range result.ControlChanges {
+			fmt.Printf("  %s: %v -> %v\n", change.Field, change.Old, change.New)
+		}
+	}
+	if len(result.ServingConfigChanges) > 0 {
+		fmt.Println("\nServing config changes:")
+		for _, change := range result.ServingConfigChanges {
+			fmt.Printf("  %s updated\n", change.Field)
+		}
+	}
 	return nil
 }
@@ -20,6 +20,7 @@ Workforce identity is configured at the project/location level and applies to en
 
 	cmd.AddCommand(NewEnginesWorkforceShowCommand())
 	cmd.AddCommand(NewEnginesWorkforceSetCommand())
+	cmd.AddCommand(NewEnginesWorkforceDescribePoolCommand())
 
 	return cmd
 }
@@ -52,29 +53,81 @@ func NewEnginesWorkforceShowCommand() *cobra.Command {
 	return cmd
 }
 
+// workforceSetPlan describes the change a workforce set would make.
+type workforceSetPlan struct {
+	Current       *client.WorkforceIdentityConfig   `json:"current"`
+	Proposed      *client.WorkforceIdentityConfig   `json:"proposed"`
+	Changed       bool                              `json:"changed"`
+	ACLDataStores []string                          `json:"aclDataStores,omitempty"`
+	Validation    *client.WorkforceValidationReport `json:"validation,omitempty"`
+}
+
 // NewEnginesWorkforceSetCommand creates the workforce set subcommand.
 func NewEnginesWorkforceSetCommand() *cobra.Command {
+	var idp string
 	var resource string
 	var clear bool
 	var workforceID string
 	var workforceProvider string
 	var workforceLocation string
+	var dryRun bool
+	var skipValidation bool
+	var force bool
 
 	cmd := &cobra.Command{
 		Use:   "set",
-		Short: "Configure the workforce identity pool",
-		Long: `Configure the workforce identity pool for the current project/location.
+		Short: "Configure the identity provider and workforce identity pool",
+		Long: `Configure the identity provider for the current project/location.
+
+--idp selects the identity provider type:
+  google       Google identities (Cloud Identity / Google Workspace, GSUITE)
+  third-party  A third-party IdP federated through a workforce identity pool
+  none         No identity provider (same as --clear)
+
+For third-party, provide the workforce pool resource (e.g.
+locations/global/workforcePools/pool-id/providers/provider-id) or specify the pool ID /
+provider ID via flags; --idp defaults to third-party when a pool is given.
+
+Before a workforce pool is linked, the pool and provider are checked with the IAM
+workforce pools API: both must exist and be enabled, and the provider must map
+google.subject. A mistyped pool or provider would otherwise lock every user out.
+Use --dry-run to compare the new configuration with the current one without applying it.
 
-Provide the workforce pool resource (e.g. locations/global/workforcePools/pool-id/providers/provider-id),
-or specify the pool ID / provider ID via flags. Use --clear to disable workforce identity.`,
+Document ACLs in data stores are bound to the IdP type they were ingested with, so
+switching between IdP types is refused while any data store has ACLs enabled unless
+--force is given.
+
+Examples:
+  gemctl engines workforce set --idp google
+  gemctl engines workforce set --workforce-id my-pool --provider-id okta --dry-run
+  gemctl engines workforce set --resource locations/global/workforcePools/my-pool/providers/okta`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if resource == "" && workforceID == "" && !clear {
-				return fmt.Errorf("provide --resource, --workforce-id or use --clear")
+			hasPool := resource != "" || workforceID != ""
+			if clear && (idp != "" || hasPool || workforceProvider != "") {
+				return fmt.Errorf("--clear cannot be combined with other flags")
 			}
-			if clear {
-				if resource != "" || workforceID != "" || workforceProvider != "" {
-					return fmt.Errorf("--clear cannot be combined with other flags")
+
+			idpType := ""
+			switch {
+			case clear:
+				idpType = client.IdpTypeUnspecified
+			case idp != "":
+				parsed, err := client.ParseIdpType(idp)
+				if err != nil {
+					return err
 				}
+				idpType = parsed
+			case hasPool:
+				idpType = client.IdpTypeThirdParty
+			default:
+				return fmt.Errorf("provide --idp, --resource, --workforce-id or use --clear")
+			}
+
+			if idpType == client.IdpTypeThirdParty && !hasPool {
+				return fmt.Errorf("--idp third-party requires --resource or --workforce-id")
+			}
+			if idpType != client.IdpTypeThirdParty && (hasPool || workforceProvider != "") {
+				return fmt.Errorf("workforce pool flags can only be used with --idp third-party")
 			}
 
 			config, err := getConfigFromFlags(cmd)
@@ -88,14 +141,65 @@ or specify the pool ID / provider ID via flags. Use --clear to disable workforce
 			}
 
 			resourceValue := ""
-			if !clear {
+			if idpType == client.IdpTypeThirdParty {
 				resourceValue, err = buildWorkforceResource(resource, workforceLocation, workforceID, workforceProvider)
 				if err != nil {
 					return err
 				}
 			}
 
-			updated, err := geminiClient.SetWorkforceIdentityConfig(resourceValue)
+			current, err := geminiClient.GetWorkforceIdentityConfig()
+			if err != nil {
+				return err
+			}
+			proposed := client.NewWorkforceIdentityConfig(idpType, resourceValue)
+
+			var aclDataStores []string
+			if current.EffectiveIdpType() != client.IdpTypeUnspecified && current.EffectiveIdpType() != idpType {
+				dataStores, err := geminiClient.ListACLDataStores()
+				if err != nil {
+					return fmt.Errorf("failed to check data store ACLs: %w", err)
+				}
+				for _, dataStore := range dataStores {
+					aclDataStores = append(aclDataStores, extractResourceID(dataStore.Name))
+				}
+			}
+
+			var report *client.WorkforceValidationReport
+			if idpType == client.IdpTypeThirdParty && !skipValidation {
+				report = geminiClient.ValidateWorkforceResource(resourceValue)
+			}
+
+			if dryRun {
+				plan := &workforceSetPlan{
+					Current:       current,
+					Proposed:      proposed,
+					Changed:       current.EffectiveIdpType() != idpType || current.WorkforcePoolName != proposed.WorkforcePoolName,
+					ACLDataStores: aclDataStores,
+					Validation:    report,
+				}
+				if err := outputWorkforceSetPlan(plan, config.Format); err != nil {
+					return err
+				}
+				if report.HasFailures() {
+					return fmt.Errorf("workforce pool validation failed")
+				}
+				return nil
+			}
+
+			if len(aclDataStores) > 0 && !force {
+				return fmt.Errorf("refusing to switch IdP type from %s to %s while %d data store(s) have ACLs enabled (%s); use --force to override",
+					current.EffectiveIdpType(), idpType, len(aclDataStores), strings.Join(aclDataStores, ", "))
+			}
+
+			if report.HasFailures() {
+				if err := outputWorkforceValidationReport(report, config.Format); err != nil {
+					return err
+				}
+				return fmt.Errorf("workforce pool validation failed; fix the issues above or use --skip-validation")
+			}
+
+			updated, err := geminiClient.SetWorkforceIdentityConfig(idpType, resourceValue)
 			if err != nil {
 				return err
 			}
@@ -104,12 +208,80 @@ or specify the pool ID / provider ID via flags. Use --clear to disable workforce
 		},
 	}
 
+	cmd.Flags().StringVar(&idp, "idp", "", "Identity provider type: google, third-party, or none")
 	cmd.Flags().StringVar(&resource, "resource", "", "Full workforce pool resource (locations/.../workforcePools/POOL[/providers/PROVIDER])")
 	cmd.Flags().StringVar(&resource, "pool", "", "Alias for --resource (deprecated)")
 	cmd.Flags().StringVar(&workforceID, "workforce-id", "", "Workforce pool ID component")
 	cmd.Flags().StringVar(&workforceProvider, "provider-id", "", "Workforce provider ID component")
 	cmd.Flags().StringVar(&workforceLocation, "workforce-location", "locations/global", "Workforce pool location (default locations/global)")
 	cmd.Flags().BoolVar(&clear, "clear", false, "Disable workforce identity (clear existing configuration)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the current and proposed configuration without applying it")
+	cmd.Flags().BoolVar(&skipValidation, "skip-validation", false, "Do not check the pool and provider with the IAM API")
+	cmd.Flags().BoolVar(&force, "force", false, "Switch IdP type even when data stores have ACLs enabled")
+
+	return cmd
+}
+
+// NewEnginesWorkforceDescribePoolCommand creates the workforce describe-pool subcommand.
+func NewEnginesWorkforceDescribePoolCommand() *cobra.Command {
+	var resource string
+	var workforceID string
+	var workforceProvider string
+	var workforceLocation string
+
+	cmd := &cobra.Command{
+		Use:   "describe-pool",
+		Short: "Describe a workforce pool and its providers' attribute mappings",
+		Long: `Describe a workforce identity pool and its identity providers, including the
+attribute mapping and condition of each provider.
+
+Without flags the pool from the current workforce identity configuration is described.
+
+Examples:
+  gemctl engines workforce describe-pool
+  gemctl engines workforce describe-pool --workforce-id my-pool --provider-id okta`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			resourceValue := ""
+			if resource != "" || workforceID != "" {
+				resourceValue, err = buildWorkforceResource(resource, workforceLocation, workforceID, workforceProvider)
+				if err != nil {
+					return err
+				}
+			} else {
+				current, err := geminiClient.GetWorkforceIdentityConfig()
+				if err != nil {
+					return err
+				}
+				if current.WorkforcePoolName == "" {
+					return fmt.Errorf("workforce identity is not configured; provide --resource or --workforce-id")
+				}
+				resourceValue = current.WorkforcePoolName
+			}
+
+			details, err := geminiClient.DescribeWorkforcePool(resourceValue)
+			if err != nil {
+				return err
+			}
+
+			return outputWorkforcePoolDetails(details, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&resource, "resource", "", "Full workforce pool resource (locations/.../workforcePools/POOL[/providers/PROVIDER])")
+	cmd.Flags().StringVar(&workforceID, "workforce-id", "", "Workforce pool ID component")
+	cmd.Flags().StringVar(&workforceProvider, "provider-id", "", "Workforce provider ID component")
+	cmd.Flags().StringVar(&workforceLocation, "workforce-location", "locations/global", "Workforce pool location (default locations/global)")
 
 	return cmd
 }
@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewEvalCommand creates the eval command group
+func NewEvalCommand() *cobra.Command {
+	evalCmd := &cobra.Command{
+		Use:   "eval",
+		Short: "Evaluate search quality offline",
+		Long: `Measure search quality of an engine against sample queries with known relevant
+documents, and compare engines or serving configs before rolling out a change.`,
+	}
+
+	evalCmd.AddCommand(NewEvalRunCommand())
+	evalCmd.AddCommand(NewEvalSampleQuerySetsCommand())
+
+	return evalCmd
+}
+
+// NewEvalRunCommand creates the eval run command
+func NewEvalRunCommand() *cobra.Command {
+	var file string
+	var sampleQuerySet string
+	var servingConfigID string
+	var compareEngine string
+	var compareServingConfig string
+	var k int
+	var concurrency int
+	var outputPath string
+	var platform bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "run ENGINE_ID",
+		Short: "Run sample queries and compute precision, recall, MRR and nDCG",
+		Long: `Run every query of a sample query set through engine search and score the top k
+results against the expected documents: precision@k, recall@k, MRR, and nDCG@k.
+
+Queries come from a local file (--file) or from a platform sample query set
+(--sample-query-set). Local files are YAML or JSON with a queries list, or JSONL with
+one query per line. Expected entries match a result by document ID, resource name, or
+URI:
+
+  queries:
+    - query: pricing plans
+      expected: [pricing-doc, https://www.example.com/pricing]
+
+Use --compare-engine and/or --compare-serving-config to evaluate a second target with
+the same queries and print a side-by-side diff. --output writes the full JSON report.
+
+With --platform the evaluation runs server-side through the evaluations API against
+--sample-query-set, and the platform's quality metrics (top 1/3/5/10) are shown.
+
+Examples:
+  gemctl eval run my-engine --file queries.yaml
+  gemctl eval run my-engine --file queries.yaml --compare-serving-config=boosted -k 5
+  gemctl eval run my-engine --file queries.jsonl --compare-engine=my-engine-v2 --output report.json
+  gemctl eval run my-engine --sample-query-set=golden --platform`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (file == "") == (sampleQuerySet == "") {
+				return fmt.Errorf("provide exactly one of --file or --sample-query-set")
+			}
+			if platform && sampleQuerySet == "" {
+				return fmt.Errorf("--platform requires --sample-query-set")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			baseline := client.ConstructServingConfigName(constructEngineName(args[0], config), servingConfigID)
+			candidate := ""
+			if compareEngine != "" || compareServingConfig != "" {
+				candidateEngine := args[0]
+				if compareEngine != "" {
+					candidateEngine = compareEngine
+				}
+				candidateServingConfig := compareServingConfig
+				if candidateServingConfig == "" {
+					candidateServingConfig = servingConfigID
+				}
+				candidate = client.ConstructServingConfigName(constructEngineName(candidateEngine, config), candidateServingConfig)
+				if candidate == baseline {
+					return fmt.Errorf("comparison target is the same serving config as the baseline")
+				}
+			}
+
+			showProgress := config.Format != "json" && config.Format != "yaml"
+
+			if platform {
+				querySetName := geminiClient.ConstructSampleQuerySetName(sampleQuerySet)
+				evaluations := []*client.Evaluation{}
+				for _, target := range []string{baseline, candidate} {
+					if target == "" {
+						continue
+					}
+					if showProgress {
+						fmt.Fprintf(os.Stderr, "Starting platform evaluation of %s...\n", evalTargetLabel(target))
+					}
+					evaluation, err := geminiClient.CreateEvaluation(target, querySetName)
+					if err != nil {
+						return fmt.Errorf("failed to start evaluation: %w", err)
+					}
+					evaluation, err = geminiClient.WaitForEvaluation(evaluation.Name, 15*time.Second, timeout, func(e *client.Evaluation) {
+						if showProgress {
+							fmt.Fprintf(os.Stderr, "  %s: %s\n", extractResourceID(e.Name), e.State)
+						}
+					})
+					if err != nil {
+						return err
+					}
+					evaluations = append(evaluations, evaluation)
+				}
+				if err := writeEvalReport(outputPath, evaluations); err != nil {
+					return err
+				}
+				return outputEvaluations(evaluations, config.Format)
+			}
+
+			var querySet *client.EvalQuerySet
+			if file != "" {
+				querySet, err = client.LoadEvalQuerySet(file)
+			} else {
+				querySet, err = geminiClient.LoadSampleQuerySet(geminiClient.ConstructSampleQuerySetName(sampleQuerySet))
+			}
+			if err != nil {
+				return err
+			}
+
+			if showProgress {
+				fmt.Fprintf(os.Stderr, "Running %d queries against %s...\n", len(querySet.Queries), evalTargetLabel(baseline))
+			}
+			baselineReport := geminiClient.RunEvaluation(baseline, querySet, k, concurrency)
+			if candidate == "" {
+				if err := writeEvalReport(outputPath, baselineReport); err != nil {
+					return err
+				}
+				return outputEvalReport(baselineReport, config.Format)
+			}
+
+			if showProgress {
+				fmt.Fprintf(os.Stderr, "Running %d queries against %s...\n", len(querySet.Queries), evalTargetLabel(candidate))
+			}
+			candidateReport := geminiClient.RunEvaluation(candidate, querySet, k, concurrency)
+			comparison := client.CompareEvalReports(baselineReport, candidateReport)
+			if err := writeEvalReport(outputPath, comparison); err != nil {
+				return err
+			}
+			return outputEvalComparison(comparison, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "Sample query file (YAML, JSON, or JSONL)")
+	cmd.Flags().StringVar(&sampleQuerySet, "sample-query-set", "", "Platform sample query set ID or resource name")
+	cmd.Flags().StringVar(&servingConfigID, "serving-config", "", "Serving config to evaluate (default: default_search)")
+	cmd.Flags().StringVar(&compareEngine, "compare-engine", "", "Engine ID to compare against")
+	cmd.Flags().StringVar(&compareServingConfig, "compare-serving-config", "", "Serving config to compare against")
+	cmd.Flags().IntVarP(&k, "k", "k", 10, "Number of top results to score (max 100)")
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "Number of concurrent search requests")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the full JSON report to this file")
+	cmd.Flags().BoolVar(&platform, "platform", false, "Run the evaluation server-side with the evaluations API")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait for a platform evaluation")
+
+	return cmd
+}
+
+// NewEvalSampleQuerySetsCommand lists platform sample query sets
+func NewEvalSampleQuerySetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sample-query-sets",
+		Aliases: []string{"query-sets"},
+		Short:   "List platform sample query sets",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			sets, err := geminiClient.ListSampleQuerySets()
+			if err != nil {
+				return fmt.Errorf("failed to list sample query sets: %w", err)
+			}
+
+			return outputSampleQuerySets(sets, config.Format)
+		},
+	}
+
+	return cmd
+}
+
+func writeEvalReport(path string, report interface{}) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal evaluation report: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write evaluation report: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Report written to %s\n", path)
+	return nil
+}
+
+// evalTargetLabel shortens a serving config name to ENGINE/SERVING_CONFIG
+func evalTargetLabel(servingConfigName string) string {
+	parts := strings.Split(servingConfigName, "/")
+	if len(parts) >= 3 && parts[len(parts)-2] == "servingConfigs" {
+		engine := ""
+		for i := 0; i+1 < len(parts); i++ {
+			if parts[i] == "engines" {
+				engine = parts[i+1]
+			}
+		}
+		if engine != "" {
+			return engine + "/" + parts[len(parts)-1]
+		}
+	}
+	return servingConfigName
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewGroundingCommand creates the grounding command group
+func NewGroundingCommand() *cobra.Command {
+	groundingCmd := &cobra.Command{
+		Use:   "grounding",
+		Short: "Check how well answers are grounded in source facts",
+		Long: `Call the grounding check API, which scores how well an answer candidate is
+supported by a set of facts and cites the facts behind each claim.`,
+	}
+
+	groundingCmd.AddCommand(NewGroundingCheckCommand())
+
+	return groundingCmd
+}
+
+// NewGroundingCheckCommand creates the grounding check command
+func NewGroundingCheckCommand() *cobra.Command {
+	var answerPath string
+	var factsPath string
+	var opts client.GroundingCheckOptions
+
+	cmd := &cobra.Command{
+		Use:   "check --answer FILE --facts FILE",
+		Short: "Score an answer against facts and show claim-level citations",
+		Long: `Check an answer candidate against facts and report the support score, each claim
+of the answer, and the facts it cites.
+
+The answer file is plain text. The facts file is one of:
+  - plain text, with facts separated by blank lines
+  - YAML or JSON, a list of strings or {factText, attributes} objects
+  - JSONL (.jsonl), one {factText, attributes} object per line
+
+Either file can be "-" to read standard input.
+
+Examples:
+  gemctl grounding check --answer answer.txt --facts facts.yaml
+  gemctl grounding check --answer - --facts facts.jsonl --claim-scores --format=json < answer.txt`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if answerPath == "" || factsPath == "" {
+				return fmt.Errorf("--answer and --facts are required")
+			}
+			if answerPath == "-" && factsPath == "-" {
+				return fmt.Errorf("only one of --answer and --facts can read standard input")
+			}
+
+			answer, err := readInputFile(answerPath)
+			if err != nil {
+				return err
+			}
+			factsData, err := readInputFile(factsPath)
+			if err != nil {
+				return err
+			}
+			facts, err := client.ParseGroundingFacts(factsData, factsPath)
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			result, err := geminiClient.CheckGrounding(strings.TrimSpace(string(answer)), facts, opts)
+			if err != nil {
+				return err
+			}
+
+			return outputGroundingCheck(result, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&answerPath, "answer", "", "Text file with the answer candidate, or - for stdin (required)")
+	cmd.Flags().StringVar(&factsPath, "facts", "", "File with the facts to check against, or - for stdin (required)")
+	cmd.Flags().Float64Var(&opts.CitationThreshold, "citation-threshold", client.DefaultCitationThreshold, "Minimum confidence (0-1) for a fact to be cited")
+	cmd.Flags().BoolVar(&opts.ClaimLevelScores, "claim-scores", false, "Include a support score for each claim")
+
+	return cmd
+}
+
+// readInputFile reads a file, or standard input when path is "-"
+func readInputFile(path string) ([]byte, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return data, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return data, nil
+}
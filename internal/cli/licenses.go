@@ -0,0 +1,391 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewLicensesCommand creates the licenses command group
+func NewLicensesCommand() *cobra.Command {
+	licensesCmd := &cobra.Command{
+		Use:     "licenses",
+		Aliases: []string{"license"},
+		Short:   "Manage Gemini Enterprise subscriptions and user licenses",
+		Long: `Manage the subscriptions (license configs) of a project location and the
+licenses of the users in its user store: list seats, assign and unassign licenses in
+bulk, and find seats nobody uses.`,
+	}
+
+	licensesCmd.PersistentFlags().String("user-store", client.DefaultUserStoreID, "User store ID")
+
+	licensesCmd.AddCommand(NewLicensesConfigsCommand())
+	licensesCmd.AddCommand(NewLicensesUsersCommand())
+	licensesCmd.AddCommand(NewLicensesAssignCommand())
+	licensesCmd.AddCommand(NewLicensesUnassignCommand())
+	licensesCmd.AddCommand(NewLicensesUnusedCommand())
+
+	return licensesCmd
+}
+
+// NewLicensesConfigsCommand lists license configs with their seat usage
+func NewLicensesConfigsCommand() *cobra.Command {
+	var licenseConfigs []string
+
+	cmd := &cobra.Command{
+		Use:     "configs",
+		Aliases: []string{"subscriptions"},
+		Short:   "List subscriptions with purchased, assigned and available seats",
+		Long: `List the license configs (subscriptions) of the user store with their tier, term,
+dates, and purchased, assigned and available seats.
+
+The API cannot list license configs directly, so the list holds the user store's
+default config and every config assigned to a user. Add others with --license-config.
+
+Examples:
+  gemctl licenses configs
+  gemctl licenses subscriptions --license-config=enterprise-2025 --format=json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			usage, err := geminiClient.GetLicenseUsage(userStoreFromFlags(cmd, geminiClient), licenseConfigs)
+			if err != nil {
+				return err
+			}
+
+			return outputLicenseConfigs(usage, config.Format)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&licenseConfigs, "license-config", nil, "Also show this license config ID (repeatable)")
+
+	return cmd
+}
+
+// NewLicensesUsersCommand lists the user licenses of the user store
+func NewLicensesUsersCommand() *cobra.Command {
+	var state string
+
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "List users of the user store and their licenses",
+		Long: `List the users of the user store with their license assignment state, license
+config, and last sign-in time.
+
+States: ASSIGNED, NO_LICENSE, NO_LICENSE_ATTEMPTED_LOGIN, BLOCKED.
+
+Examples:
+  gemctl licenses users
+  gemctl licenses users --state=NO_LICENSE_ATTEMPTED_LOGIN`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			users, err := geminiClient.ListUserLicenses(userStoreFromFlags(cmd, geminiClient), state)
+			if err != nil {
+				return err
+			}
+
+			return outputUserLicenses(users, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&state, "state", "", "Only users in this license assignment state")
+
+	return cmd
+}
+
+// NewLicensesAssignCommand assigns a license config to users from a CSV file
+func NewLicensesAssignCommand() *cobra.Command {
+	var licenseConfigID string
+	var file string
+	var dryRun bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "assign --license-config ID --file USERS.csv",
+		Short: "Assign a subscription to users listed in a CSV file",
+		Long: `Assign a license config to every user in a CSV file. The email column is the one
+headed email, user, or userPrincipal; without such a header the first column is used.
+Use --file - to read standard input.
+
+Users who already hold the license are skipped. Users holding another license are
+moved to this one. The command fails if the subscription does not have enough
+available seats.
+
+Examples:
+  gemctl licenses assign --license-config=enterprise-2025 --file new-hires.csv --dry-run
+  gemctl licenses assign --license-config=enterprise-2025 --file new-hires.csv --force`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if licenseConfigID == "" || file == "" {
+				return fmt.Errorf("--license-config and --file are required")
+			}
+
+			if file == "-" && !force && !dryRun {
+				return fmt.Errorf("--force is required when reading users from stdin")
+			}
+
+			principals, err := readUserPrincipals(file)
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			userStoreName := userStoreFromFlags(cmd, geminiClient)
+			licenseConfigName := geminiClient.ConstructLicenseConfigName(licenseConfigID)
+			usage, err := geminiClient.GetLicenseUsage(userStoreName, []string{licenseConfigName})
+			if err != nil {
+				return err
+			}
+
+			plan := client.PlanLicenseAssignment(usage.Users, licenseConfigName, principals)
+			if err := outputLicenseUpdatePlan(plan, "assign", config.Format); err != nil {
+				return err
+			}
+			if len(plan.Update) == 0 {
+				fmt.Println("All users already hold this license; nothing to do.")
+				return nil
+			}
+
+			for _, licenseConfig := range usage.Configs {
+				if licenseConfig.Name != licenseConfigName {
+					continue
+				}
+				if licenseConfig.State != "" && licenseConfig.State != "ACTIVE" {
+					return fmt.Errorf("license config %s is %s", licenseConfigID, licenseConfig.State)
+				}
+				if int64(len(plan.Update)) > licenseConfig.AvailableCount {
+					return fmt.Errorf("%d user(s) need a seat, but license config %s has %d available",
+						len(plan.Update), licenseConfigID, licenseConfig.AvailableCount)
+				}
+			}
+
+			if dryRun {
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Assign license config %s to %d user(s)? (y/N): ", licenseConfigID, len(plan.Update))
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Assignment cancelled.")
+					return nil
+				}
+			}
+
+			result, err := geminiClient.AssignLicenses(userStoreName, licenseConfigName, plan.Update)
+			if result != nil {
+				if outputErr := outputLicenseUpdateResult(result, "Assigned", config.Format); outputErr != nil && err == nil {
+					err = outputErr
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if result.FailedCount > 0 {
+				return fmt.Errorf("%d of %d license assignment(s) failed", result.FailedCount, len(plan.Update))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&licenseConfigID, "license-config", "", "License config ID to assign (required)")
+	cmd.Flags().StringVarP(&file, "file", "F", "", "CSV file with user emails, or - for stdin (required)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show which users would change")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewLicensesUnassignCommand removes the licenses of users from a CSV file
+func NewLicensesUnassignCommand() *cobra.Command {
+	var file string
+	var deleteUsers bool
+	var dryRun bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "unassign --file USERS.csv",
+		Short: "Remove the licenses of users listed in a CSV file",
+		Long: `Remove the license of every user in a CSV file, freeing their seats. The CSV
+layout is the same as for 'gemctl licenses assign'. Users without a license are
+skipped. With --delete-users the users are removed from the user store instead of
+being left without a license.
+
+Examples:
+  gemctl licenses unassign --file leavers.csv --dry-run
+  gemctl licenses unassign --file leavers.csv --delete-users --force`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+
+			if file == "-" && !force && !dryRun {
+				return fmt.Errorf("--force is required when reading users from stdin")
+			}
+
+			principals, err := readUserPrincipals(file)
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			userStoreName := userStoreFromFlags(cmd, geminiClient)
+			users, err := geminiClient.ListUserLicenses(userStoreName, "")
+			if err != nil {
+				return err
+			}
+
+			plan := client.PlanLicenseUnassignment(users, principals)
+			if err := outputLicenseUpdatePlan(plan, "unassign", config.Format); err != nil {
+				return err
+			}
+			if len(plan.Update) == 0 {
+				fmt.Println("No listed user holds a license; nothing to do.")
+				return nil
+			}
+
+			if dryRun {
+				fmt.Println("Dry run complete. No changes applied.")
+				return nil
+			}
+
+			if !force {
+				prompt := fmt.Sprintf("Remove the licenses of %d user(s)? (y/N): ", len(plan.Update))
+				if deleteUsers {
+					prompt = fmt.Sprintf("Remove the licenses of %d user(s) and delete them from the user store? (y/N): ", len(plan.Update))
+				}
+				if proceed, err := promptForConfirmation(prompt); err != nil || !proceed {
+					if err != nil {
+						return err
+					}
+					fmt.Println("Unassignment cancelled.")
+					return nil
+				}
+			}
+
+			result, err := geminiClient.UnassignLicenses(userStoreName, plan.Update, deleteUsers)
+			if result != nil {
+				if outputErr := outputLicenseUpdateResult(result, "Unassigned", config.Format); outputErr != nil && err == nil {
+					err = outputErr
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if result.FailedCount > 0 {
+				return fmt.Errorf("%d of %d license unassignment(s) failed", result.FailedCount, len(plan.Update))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "CSV file with user emails, or - for stdin (required)")
+	cmd.Flags().BoolVar(&deleteUsers, "delete-users", false, "Delete the users from the user store instead of leaving them unlicensed")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show which users would change")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// NewLicensesUnusedCommand reports unused seats
+func NewLicensesUnusedCommand() *cobra.Command {
+	var inactiveDays int
+	var licenseConfigs []string
+
+	cmd := &cobra.Command{
+		Use:   "unused",
+		Short: "Report available seats and licensed users who have not signed in",
+		Long: `Report seats that nobody holds in active subscriptions, and licensed users who
+have not signed in within --inactive-days. Users who never signed in count from the
+time their license was last updated. Pipe the JSON output through jq to build a CSV
+for 'gemctl licenses unassign'.
+
+Examples:
+  gemctl licenses unused
+  gemctl licenses unused --inactive-days=60 --format=json | jq -r '.inactiveUsers[].userPrincipal'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inactiveDays <= 0 {
+				return fmt.Errorf("--inactive-days must be positive")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			usage, err := geminiClient.GetLicenseUsage(userStoreFromFlags(cmd, geminiClient), licenseConfigs)
+			if err != nil {
+				return err
+			}
+
+			return outputUnusedSeats(usage.UnusedSeats(inactiveDays, time.Now()), config.Format)
+		},
+	}
+
+	cmd.Flags().IntVar(&inactiveDays, "inactive-days", 30, "Days without sign-in after which a licensed user counts as inactive")
+	cmd.Flags().StringArrayVar(&licenseConfigs, "license-config", nil, "Also include this license config ID (repeatable)")
+
+	return cmd
+}
+
+func userStoreFromFlags(cmd *cobra.Command, geminiClient *client.GeminiClient) string {
+	userStore, _ := cmd.Flags().GetString("user-store")
+	return geminiClient.ConstructUserStoreName(userStore)
+}
+
+func readUserPrincipals(path string) ([]string, error) {
+	data, err := readInputFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return client.ParseUserPrincipalsCSV(data, path)
+}
@@ -169,23 +169,27 @@ func outputAgentsTable(agents []*client.Agent) error {
 	}
 
 	fmt.Println("=" + strings.Repeat("=", 120))
-	fmt.Printf("%-30s %-35s %-45s\n", "AGENT ID", "DISPLAY NAME", "DIALOGFLOW AGENT")
+	fmt.Printf("%-30s %-30s %-12s %-45s\n", "AGENT ID", "DISPLAY NAME", "KIND", "BACKEND")
 	fmt.Println("=" + strings.Repeat("=", 120))
 
 	for _, agent := range agents {
 		agentID := extractResourceID(agent.Name)
-		dialogflowAgent := ""
-		if agent.DialogflowAgentDefinition != nil {
-			dialogflowAgent = agent.DialogflowAgentDefinition.DialogflowAgent
+		backend := ""
+		switch agent.Kind() {
+		case client.AgentKindDialogflow:
+			backend = agent.DialogflowAgentDefinition.DialogflowAgent
+		case client.AgentKindADK:
+			backend = agent.AdkReasoningEngine()
 		}
-		if dialogflowAgent == "" {
-			dialogflowAgent = "N/A"
+		if backend == "" {
+			backend = "N/A"
 		}
 
-		fmt.Printf("%-30s %-35s %-45s\n",
+		fmt.Printf("%-30s %-30s %-12s %-45s\n",
 			agentID,
-			truncateString(agent.DisplayName, 35),
-			truncateString(dialogflowAgent, 45),
+			truncateString(agent.DisplayName, 30),
+			agent.Kind(),
+			truncateString(backend, 45),
 		)
 	}
 
@@ -205,19 +209,306 @@ func outputAgentDetails(agent *client.Agent, format string) error {
 	}
 }
 
+// outputAssistants outputs a list of assistants in the specified format
+func outputAssistants(assistants []*client.Assistant, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(assistants, format)
+	case "yaml":
+		return outputYAML(assistants)
+	default:
+		if len(assistants) == 0 {
+			fmt.Println("No assistants found.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-25s %-35s %-40s\n", "ASSISTANT ID", "DISPLAY NAME", "WEB GROUNDING")
+		fmt.Println("=" + strings.Repeat("=", 100))
+		for _, assistant := range assistants {
+			id := extractResourceID(assistant.Name)
+			if assistant.IsDefaultAssistant() {
+				id += " (default)"
+			}
+			fmt.Printf("%-25s %-35s %-40s\n",
+				truncateString(id, 25),
+				truncateString(valueOrPlaceholder(assistant.DisplayName), 35),
+				valueOrPlaceholder(assistant.WebGroundingType))
+		}
+		fmt.Printf("\nTotal: %d assistant(s)\n", len(assistants))
+		return nil
+	}
+}
+
+// outputAssistantDetails outputs assistant configuration in the specified format
+func outputAssistantDetails(assistant *client.Assistant, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(assistant, format)
+	case "yaml":
+		return outputYAML(assistant)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Assistant: %s\n", valueOrPlaceholder(assistant.DisplayName))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Name: %s\n", assistant.Name)
+		if assistant.Description != "" {
+			fmt.Printf("Description: %s\n", assistant.Description)
+		}
+		fmt.Printf("Default Language: %s\n", valueOrPlaceholder(assistant.DefaultLanguage()))
+		fmt.Printf("Web Grounding: %s\n", valueOrPlaceholder(assistant.WebGroundingType))
+		if assistant.DefaultWebGroundingToggleOff {
+			fmt.Println("Web Grounding Toggle: off by default")
+		}
+		if instruction := assistant.SystemInstruction(); instruction != "" {
+			fmt.Printf("\nSystem Instruction:\n  %s\n", strings.ReplaceAll(instruction, "\n", "\n  "))
+		}
+		if len(assistant.EnabledTools) > 0 {
+			tools := make([]string, 0, len(assistant.EnabledTools))
+			for tool := range assistant.EnabledTools {
+				tools = append(tools, tool)
+			}
+			sort.Strings(tools)
+			fmt.Printf("\nEnabled Tools (%d):\n", len(tools))
+			for _, tool := range tools {
+				fmt.Printf("  - %s\n", tool)
+			}
+		}
+		if len(assistant.CustomerPolicy) > 0 {
+			fmt.Println("\nCustomer Policy: configured (use --format=yaml for details)")
+		}
+		return nil
+	}
+}
+
+// outputServingConfigs outputs serving configs in the specified format
+func outputServingConfigs(servingConfigs []*client.ServingConfig, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(servingConfigs, format)
+	case "yaml":
+		return outputYAML(servingConfigs)
+	default:
+		if len(servingConfigs) == 0 {
+			fmt.Println("No serving configs found.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-30s %-35s %-15s %-15s\n", "SERVING CONFIG ID", "DISPLAY NAME", "DIVERSITY", "CONTROLS")
+		fmt.Println("=" + strings.Repeat("=", 100))
+		for _, servingConfig := range servingConfigs {
+			linked := 0
+			for _, ids := range servingConfig.LinkedControlIDs() {
+				linked += len(ids)
+			}
+			fmt.Printf("%-30s %-35s %-15s %-15d\n",
+				truncateString(extractResourceID(servingConfig.Name), 30),
+				truncateString(valueOrPlaceholder(servingConfig.DisplayName), 35),
+				truncateString(valueOrPlaceholder(servingConfig.DiversityLevel), 15),
+				linked)
+		}
+		fmt.Printf("\nTotal: %d serving config(s)\n", len(servingConfigs))
+		return nil
+	}
+}
+
+// outputServingConfigDetails outputs a serving config in the specified format
+func outputServingConfigDetails(servingConfig *client.ServingConfig, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(servingConfig, format)
+	case "yaml":
+		return outputYAML(servingConfig)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Serving Config: %s\n", extractResourceID(servingConfig.Name))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Name: %s\n", servingConfig.Name)
+		fmt.Printf("Display Name: %s\n", valueOrPlaceholder(servingConfig.DisplayName))
+		fmt.Printf("Solution Type: %s\n", valueOrPlaceholder(servingConfig.SolutionType))
+		if servingConfig.ModelID != "" {
+			fmt.Printf("Model ID: %s\n", servingConfig.ModelID)
+		}
+		fmt.Printf("Diversity Level: %s\n", valueOrPlaceholder(servingConfig.DiversityLevel))
+		fmt.Printf("Ranking Expression: %s\n", valueOrPlaceholder(servingConfig.RankingExpression))
+		fmt.Printf("Updated: %s\n", valueOrPlaceholder(servingConfig.UpdateTime))
+
+		linked := servingConfig.LinkedControlIDs()
+		if len(linked) == 0 {
+			fmt.Println("\nLinked Controls: none")
+		} else {
+			fields := make([]string, 0, len(linked))
+			for field := range linked {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			fmt.Println("\nLinked Controls:")
+			for _, field := range fields {
+				fmt.Printf("  %s: %s\n", field, strings.Join(linked[field], ", "))
+			}
+		}
+		if len(servingConfig.GenericConfig) > 0 || len(servingConfig.AnswerGenerationSpec) > 0 {
+			fmt.Println("\nSearch and answer specs: configured (use --format=yaml for details)")
+		}
+		return nil
+	}
+}
+
+// outputControls outputs controls in the specified format
+func outputControls(controls []*client.Control, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(controls, format)
+	case "yaml":
+		return outputYAML(controls)
+	default:
+		if len(controls) == 0 {
+			fmt.Println("No controls found.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("%-30s %-30s %-10s %-10s %-25s\n", "CONTROL ID", "DISPLAY NAME", "TYPE", "CONDITIONS", "SERVING CONFIGS")
+		fmt.Println("=" + strings.Repeat("=", 110))
+		for _, control := range controls {
+			fmt.Printf("%-30s %-30s %-10s %-10d %-25s\n",
+				truncateString(control.ControlID(), 30),
+				truncateString(valueOrPlaceholder(control.DisplayName), 30),
+				control.ActionType(),
+				len(control.Conditions),
+				truncateString(valueOrPlaceholder(strings.Join(control.AssociatedServingConfigIds, ",")), 25))
+		}
+		fmt.Printf("\nTotal: %d control(s)\n", len(controls))
+		return nil
+	}
+}
+
+// outputControlDetails outputs a control in the specified format
+func outputControlDetails(control *client.Control, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(control, format)
+	case "yaml":
+		return outputYAML(control)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Control: %s\n", valueOrPlaceholder(control.DisplayName))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Name: %s\n", control.Name)
+		fmt.Printf("Type: %s\n", valueOrPlaceholder(control.ActionType()))
+		fmt.Printf("Solution Type: %s\n", valueOrPlaceholder(control.SolutionType))
+		if len(control.UseCases) > 0 {
+			fmt.Printf("Use Cases: %s\n", strings.Join(control.UseCases, ", "))
+		}
+		fmt.Printf("Serving Configs: %s\n", valueOrPlaceholder(strings.Join(control.AssociatedServingConfigIds, ", ")))
+
+		fmt.Println("\nAction:")
+		switch {
+		case control.BoostAction != nil:
+			boost := control.BoostAction.FixedBoost
+			if boost == 0 {
+				boost = control.BoostAction.Boost
+			}
+			fmt.Printf("  Boost: %g\n", boost)
+			fmt.Printf("  Filter: %s\n", valueOrPlaceholder(control.BoostAction.Filter))
+			fmt.Printf("  Data Store: %s\n", valueOrPlaceholder(extractResourceID(control.BoostAction.DataStore)))
+		case control.FilterAction != nil:
+			fmt.Printf("  Filter: %s\n", valueOrPlaceholder(control.FilterAction.Filter))
+			fmt.Printf("  Data Store: %s\n", valueOrPlaceholder(extractResourceID(control.FilterAction.DataStore)))
+		case control.RedirectAction != nil:
+			fmt.Printf("  Redirect URI: %s\n", control.RedirectAction.RedirectURI)
+		case control.SynonymsAction != nil:
+			fmt.Printf("  Synonyms: %s\n", strings.Join(control.SynonymsAction.Synonyms, ", "))
+		case control.PromoteAction != nil:
+			fmt.Printf("  Data Store: %s\n", valueOrPlaceholder(extractResourceID(control.PromoteAction.DataStore)))
+			fmt.Printf("  Promoted Link: %v\n", control.PromoteAction.SearchLinkPromotion["uri"])
+		default:
+			fmt.Println("  (not set)")
+		}
+
+		if len(control.Conditions) == 0 {
+			fmt.Println("\nConditions: always applies")
+		} else {
+			fmt.Println("\nConditions:")
+			for i, condition := range control.Conditions {
+				parts := []string{}
+				for _, term := range condition.QueryTerms {
+					if term.FullMatch {
+						parts = append(parts, fmt.Sprintf("query = %q", term.Value))
+					} else {
+						parts = append(parts, fmt.Sprintf("query contains %q", term.Value))
+					}
+				}
+				if condition.QueryRegex != "" {
+					parts = append(parts, fmt.Sprintf("query matches /%s/", condition.QueryRegex))
+				}
+				for _, window := range condition.ActiveTimeRange {
+					parts = append(parts, fmt.Sprintf("active %s to %s", valueOrPlaceholder(window.StartTime), valueOrPlaceholder(window.EndTime)))
+				}
+				fmt.Printf("  %d. %s\n", i+1, strings.Join(parts, " AND "))
+			}
+		}
+		return nil
+	}
+}
+
+// outputControlChanges outputs planned control changes
+func outputControlChanges(changes []client.FieldDiff, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(changes, format)
+	case "yaml":
+		return outputYAML(changes)
+	default:
+		fmt.Println("Control changes:")
+		for _, change := range changes {
+			switch {
+			case change.Old == "missing":
+				fmt.Printf("  + %s (%v)\n", change.Field, change.New)
+			case change.New == "missing":
+				fmt.Printf("  - %s (%v)\n", change.Field, change.Old)
+			default:
+				fmt.Printf("  ~ %s\n", change.Field)
+			}
+		}
+		fmt.Println()
+		return nil
+	}
+}
+
 // outputAgentDetailsTable outputs detailed agent information in table format
 func outputAgentDetailsTable(agent *client.Agent) error {
 	fmt.Println("=" + strings.Repeat("=", 80))
 	fmt.Printf("Agent: %s\n", agent.DisplayName)
 	fmt.Println("=" + strings.Repeat("=", 80))
 	fmt.Printf("Name: %s\n", agent.Name)
+	fmt.Printf("Kind: %s\n", agent.Kind())
+	if agent.State != "" {
+		fmt.Printf("State: %s\n", agent.State)
+	}
 	fmt.Printf("Description: %s\n", agent.Description)
-	fmt.Printf("Reasoning Engine: %s\n", agent.ReasoningEngine)
+	if agent.ReasoningEngine != "" {
+		fmt.Printf("Reasoning Engine: %s\n", agent.ReasoningEngine)
+	}
 
 	if agent.DialogflowAgentDefinition != nil {
 		fmt.Printf("Dialogflow Agent: %s\n", agent.DialogflowAgentDefinition.DialogflowAgent)
 	}
 
+	if adk := agent.AdkAgentDefinition; adk != nil {
+		fmt.Printf("Agent Engine: %s\n", valueOrPlaceholder(agent.AdkReasoningEngine()))
+		if adk.ToolSettings != nil && adk.ToolSettings.ToolDescription != "" {
+			fmt.Printf("Tool Description: %s\n", adk.ToolSettings.ToolDescription)
+		}
+		if len(adk.Authorizations) > 0 {
+			fmt.Println("Authorizations:")
+			for _, authorization := range adk.Authorizations {
+				fmt.Printf("  - %s\n", authorization)
+			}
+		}
+	}
+
 	if agent.Icon != nil {
 		if agent.Icon.URI != "" {
 			fmt.Printf("Icon URI: %s\n", agent.Icon.URI)
@@ -231,177 +522,1450 @@ func outputAgentDetailsTable(agent *client.Agent) error {
 		for _, capability := range agent.Capabilities {
 			fmt.Printf("  - %s\n", capability)
 		}
-	}
+	}
+
+	if len(agent.Labels) > 0 {
+		fmt.Println("\nLabels:")
+		for key, value := range agent.Labels {
+			fmt.Printf("  %s: %s\n", key, value)
+		}
+	}
+
+	if len(agent.Annotations) > 0 {
+		fmt.Println("\nAnnotations:")
+		for key, value := range agent.Annotations {
+			fmt.Printf("  %s: %s\n", key, value)
+		}
+	}
+
+	if agent.CreateTime != "" {
+		fmt.Printf("\nCreated: %s\n", agent.CreateTime)
+	}
+	if agent.UpdateTime != "" {
+		fmt.Printf("Updated: %s\n", agent.UpdateTime)
+	}
+
+	return nil
+}
+
+// outputAgentValidationReport outputs a Dialogflow agent validation report in the specified format
+func outputAgentValidationReport(report *client.AgentValidationReport, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(report, format)
+	case "yaml":
+		return outputYAML(report)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Dialogflow Agent: %s\n", report.DialogflowAgent)
+		if report.DisplayName != "" {
+			fmt.Printf("Display Name: %s\n", report.DisplayName)
+		}
+		fmt.Println("=" + strings.Repeat("=", 80))
+
+		printValidationChecks(report.Checks)
+		return nil
+	}
+}
+
+// outputAssistResultSummary prints latency, state and errors after a streamed response
+func outputAssistResultSummary(result *client.AssistResult) {
+	fmt.Println("-" + strings.Repeat("-", 80))
+	fmt.Printf("Latency: %d ms (first chunk %d ms)\n", result.LatencyMs, result.FirstChunkLatency)
+	if result.State != "" {
+		fmt.Printf("State: %s\n", result.State)
+	}
+	if result.Session != "" {
+		fmt.Printf("Session: %s\n", result.Session)
+	}
+	if len(result.Errors) > 0 {
+		fmt.Println("Errors:")
+		for _, message := range result.Errors {
+			fmt.Printf("  - %s\n", message)
+		}
+	}
+}
+
+// outputAgentTestOutcomes outputs agent test suite results in the specified format
+func outputAgentTestOutcomes(outcomes []agentTestOutcome, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(outcomes, format)
+	case "yaml":
+		return outputYAML(outcomes)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-30s %-8s %-12s %-45s\n", "TEST", "STATUS", "LATENCY", "DETAIL")
+		fmt.Println("=" + strings.Repeat("=", 100))
+
+		passed := 0
+		for _, outcome := range outcomes {
+			status := "FAIL"
+			if outcome.Passed {
+				status = "PASS"
+				passed++
+			}
+			latency := "N/A"
+			if outcome.Result != nil {
+				latency = fmt.Sprintf("%d ms", outcome.Result.LatencyMs)
+			}
+			detail := outcome.Error
+			if detail == "" && len(outcome.Missing) > 0 {
+				detail = "missing: " + strings.Join(outcome.Missing, ", ")
+			}
+			fmt.Printf("%-30s %-8s %-12s %-45s\n", truncateString(outcome.Name, 30), status, latency, truncateString(detail, 45))
+		}
+
+		fmt.Printf("\nPassed: %d/%d\n", passed, len(outcomes))
+		return nil
+	}
+}
+
+// outputAgentApplyResults outputs agent apply results in the specified format
+func outputAgentApplyResults(results []client.AgentApplyResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(results, format)
+	case "yaml":
+		return outputYAML(results)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-35s %-10s %-10s %-40s\n", "AGENT", "CHANGE", "STATUS", "ERROR")
+		fmt.Println("=" + strings.Repeat("=", 100))
+
+		counts := map[string]int{}
+		for _, result := range results {
+			counts[result.Status]++
+			name := result.DisplayName
+			if name == "" {
+				name = result.Key
+			}
+			fmt.Printf("%-35s %-10s %-10s %-40s\n",
+				truncateString(name, 35),
+				result.ChangeType,
+				result.Status,
+				truncateString(result.Error, 40),
+			)
+		}
+
+		fmt.Printf("\nTotal: %d change(s)", len(results))
+		for _, status := range []string{"planned", "success", "error"} {
+			if counts[status] > 0 {
+				fmt.Printf(", %d %s", counts[status], status)
+			}
+		}
+		fmt.Println()
+		return nil
+	}
+}
+
+// outputAgentAccess outputs agent access entries in the specified format
+func outputAgentAccess(entries []client.AgentAccessEntry, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(entries, format)
+	case "yaml":
+		return outputYAML(entries)
+	default:
+		if len(entries) == 0 {
+			fmt.Println("No access bindings found for this agent.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 120))
+		fmt.Printf("%-40s %-80s\n", "ROLE", "MEMBER")
+		fmt.Println("=" + strings.Repeat("=", 120))
+		for _, entry := range entries {
+			fmt.Printf("%-40s %-80s\n", entry.Role, entry.Member)
+		}
+		fmt.Printf("\nTotal: %d binding(s)\n", len(entries))
+		return nil
+	}
+}
+
+// outputEngineFeatures outputs engine features in the specified format.
+func outputEngineFeatures(engine *client.Engine, format string) error {
+	if engine == nil {
+		return fmt.Errorf("engine is nil")
+	}
+
+	switch format {
+	case "json":
+		return outputJSON(engine.Features, format)
+	case "yaml":
+		return outputYAML(engine.Features)
+	default:
+		return outputEngineFeaturesTable(engine)
+	}
+}
+
+func outputEngineFeaturesTable(engine *client.Engine) error {
+	features := engine.Features
+	if len(features) == 0 {
+		fmt.Println("No feature configuration found for this engine.")
+		return nil
+	}
+
+	keys := sortedFeatureKeys(features)
+	enabled := 0
+
+	fmt.Println("=" + strings.Repeat("=", 110))
+	fmt.Printf("Features for engine: %s\n", engine.DisplayName)
+	fmt.Println("=" + strings.Repeat("=", 110))
+	fmt.Printf("%-40s %-8s %-60s\n", "FEATURE", "STATE", "DESCRIPTION")
+	fmt.Println("-" + strings.Repeat("-", 110))
+
+	for _, key := range keys {
+		state := features[key]
+		if strings.Contains(state, "ON") {
+			enabled++
+		}
+		description := "(not in feature catalog)"
+		if definition, ok := client.LookupFeature(key); ok {
+			description = definition.Description
+		}
+		fmt.Printf("%-40s %-8s %-60s\n", key, renderFeatureState(state), truncateString(description, 60))
+	}
+
+	fmt.Printf("\nEnabled: %d/%d\n", enabled, len(features))
+
+	conflicts := client.CheckFeatureConflicts(features)
+	if len(conflicts) > 0 {
+		fmt.Println("\nWarnings:")
+		for _, conflict := range conflicts {
+			fmt.Printf("  - %s\n", conflict.Message)
+		}
+	}
+	return nil
+}
+
+// outputFeatureProfilePlans outputs per-engine feature profile changes
+func outputFeatureProfilePlans(plans []featureProfilePlan, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(plans, format)
+	case "yaml":
+		return outputYAML(plans)
+	default:
+		for _, plan := range plans {
+			fmt.Printf("Engine: %s [%s]\n", plan.Engine, plan.Status)
+			if plan.Error != "" {
+				fmt.Printf("  Error: %s\n", plan.Error)
+			}
+			for _, change := range plan.Changes {
+				fmt.Printf("  %s: %s -> %s\n", change.Feature, valueOrPlaceholder(renderFeatureState(change.Old)), renderFeatureState(change.New))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+}
+
+// outputFeatureComplianceReport outputs a feature profile compliance report
+func outputFeatureComplianceReport(report *client.FeatureComplianceReport, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(report, format)
+	case "yaml":
+		return outputYAML(report)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-40s %-20s %-12s %-25s\n", "ENGINE", "PROFILE", "STATUS", "DEVIATIONS")
+		fmt.Println("=" + strings.Repeat("=", 100))
+		for _, entry := range report.Entries {
+			status := "COMPLIANT"
+			detail := "-"
+			switch {
+			case entry.Error != "":
+				status = "ERROR"
+				detail = entry.Error
+			case !entry.Compliant:
+				status = "DEVIATES"
+				features := make([]string, 0, len(entry.Deviations))
+				for _, deviation := range entry.Deviations {
+					features = append(features, deviation.Feature)
+				}
+				detail = strings.Join(features, ", ")
+			}
+			fmt.Printf("%-40s %-20s %-12s %-25s\n",
+				truncateString(extractResourceID(entry.Engine), 40),
+				truncateString(entry.Profile, 20),
+				status,
+				truncateString(detail, 60))
+		}
+		fmt.Printf("\nDeviating: %d/%d (catalog %s)\n", report.DeviatingEngines(), len(report.Entries), report.CatalogVersion)
+		return nil
+	}
+}
+
+// outputPolicyResults outputs policy check results in the specified format
+func outputPolicyResults(results []client.PolicyResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(results, format)
+	case "yaml":
+		return outputYAML(results)
+	default:
+		errorCount, warningCount := client.PolicyViolations(results)
+		if errorCount == 0 && warningCount == 0 {
+			fmt.Printf("All %d policy check(s) passed.\n", len(results))
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("%-30s %-8s %-40s %-30s\n", "RULE", "LEVEL", "RESOURCE", "MESSAGE")
+		fmt.Println("=" + strings.Repeat("=", 110))
+		for _, result := range results {
+			if result.Passed {
+				continue
+			}
+			fmt.Printf("%-30s %-8s %-40s %s\n",
+				truncateString(result.RuleID, 30),
+				strings.ToUpper(result.Severity),
+				truncateString(extractResourceID(result.Resource), 40),
+				result.Message)
+		}
+		fmt.Printf("\nChecks: %d, errors: %d, warnings: %d\n", len(results), errorCount, warningCount)
+		return nil
+	}
+}
+
+// outputFeatureCatalog outputs the built-in feature catalog in the specified format
+func outputFeatureCatalog(definitions []client.FeatureDefinition, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(map[string]interface{}{
+			"version":  client.FeatureCatalogVersion,
+			"features": definitions,
+		}, format)
+	case "yaml":
+		return outputYAML(map[string]interface{}{
+			"version":  client.FeatureCatalogVersion,
+			"features": definitions,
+		})
+	default:
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("Feature catalog %s\n", client.FeatureCatalogVersion)
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("%-40s %-8s %-60s\n", "FEATURE", "DEFAULT", "DESCRIPTION")
+		fmt.Println("-" + strings.Repeat("-", 110))
+
+		for _, definition := range definitions {
+			defaultState := "-"
+			if definition.DefaultState != "" {
+				defaultState = renderFeatureState(definition.DefaultState)
+			}
+			fmt.Printf("%-40s %-8s %-60s\n", definition.Key, defaultState, truncateString(definition.Description, 60))
+
+			var rules []string
+			if definition.Inverse != "" {
+				rules = append(rules, "inverse of "+definition.Inverse)
+			}
+			if len(definition.Requires) > 0 {
+				rules = append(rules, "requires "+strings.Join(definition.Requires, ", "))
+			}
+			if len(rules) > 0 {
+				fmt.Printf("%-40s %-8s %s\n", "", "", strings.Join(rules, "; "))
+			}
+		}
+
+		fmt.Printf("\nTotal: %d feature(s)\n", len(definitions))
+		return nil
+	}
+}
+
+// outputDataStores outputs data stores in the specified format
+func outputDataStores(dataStores []*client.DataStore, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(dataStores, format)
+	case "yaml":
+		return outputYAML(dataStores)
+	default:
+		return outputDataStoresTable(dataStores)
+	}
+}
+
+// outputDataStoresTable outputs data stores in table format
+func outputDataStoresTable(dataStores []*client.DataStore) error {
+	if len(dataStores) == 0 {
+		fmt.Println("No data stores found.")
+		return nil
+	}
+
+	fmt.Println("=" + strings.Repeat("=", 100))
+	fmt.Printf("%-50s %-30s %-20s\n", "NAME", "DISPLAY NAME", "CONTENT CONFIG")
+	fmt.Println("=" + strings.Repeat("=", 100))
+
+	for _, ds := range dataStores {
+		name := extractResourceID(ds.Name)
+		displayName := ds.DisplayName
+		contentConfig := ds.ContentConfig
+
+		fmt.Printf("%-50s %-30s %-20s\n", name, displayName, contentConfig)
+	}
+
+	fmt.Printf("\nTotal: %d data store(s)\n", len(dataStores))
+	return nil
+}
+
+// outputDataStoreDetails outputs data store details in the specified format
+func outputDataStoreDetails(dataStore *client.DataStore, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(dataStore, format)
+	case "yaml":
+		return outputYAML(dataStore)
+	default:
+		return outputDataStoreDetailsTable(dataStore)
+	}
+}
+
+// outputDataStoreDetailsTable outputs data store details in table format
+func outputDataStoreDetailsTable(dataStore *client.DataStore) error {
+	fmt.Println("=" + strings.Repeat("=", 80))
+	fmt.Printf("Data Store: %s\n", dataStore.DisplayName)
+	fmt.Println("=" + strings.Repeat("=", 80))
+	fmt.Printf("Name: %s\n", dataStore.Name)
+	fmt.Printf("Industry Vertical: %s\n", dataStore.IndustryVertical)
+	fmt.Printf("Content Config: %s\n", dataStore.ContentConfig)
+	fmt.Printf("Created: %s\n", dataStore.CreateTime)
+
+	if len(dataStore.SolutionTypes) > 0 {
+		fmt.Printf("Solution Types: %s\n", strings.Join(dataStore.SolutionTypes, ", "))
+	}
+
+	if dataStore.AclEnabled {
+		fmt.Printf("ACL Enabled: %t\n", dataStore.AclEnabled)
+	}
+
+	if dataStore.BillingEstimation != nil {
+		size := float64(dataStore.BillingEstimation.UnstructuredDataSize) / (1024 * 1024)
+		fmt.Printf("\nBilling Estimation:\n")
+		fmt.Printf("  Size: %.2f MB\n", size)
+		fmt.Printf("  Updated: %s\n", dataStore.BillingEstimation.UnstructuredDataUpdateTime)
+	}
+
+	if dataStore.DocumentProcessingConfig != nil {
+		fmt.Println("\nDocument Processing:")
+		printDocumentProcessingConfig(dataStore.DocumentProcessingConfig)
+	}
+
+	if dataStore.Schema != nil {
+		if schemaName, ok := dataStore.Schema["name"].(string); ok {
+			fmt.Printf("\nSchema: %s\n", schemaName)
+		}
+	}
+
+	return nil
+}
+
+// outputDocumentProcessingConfig outputs a data store's document processing config
+func outputDocumentProcessingConfig(dataStoreID string, config *client.DocumentProcessingConfig, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(config, format)
+	case "yaml":
+		return outputYAML(config)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Document Processing: %s\n", dataStoreID)
+		fmt.Println("=" + strings.Repeat("=", 80))
+		printDocumentProcessingConfig(config)
+		return nil
+	}
+}
+
+func printDocumentProcessingConfig(config *client.DocumentProcessingConfig) {
+	fmt.Printf("  Default Parser: %s\n", describeDocumentParser(config.DefaultParsingConfig))
+
+	if len(config.ParsingConfigOverrides) > 0 {
+		fmt.Println("  Parser Overrides:")
+		fileTypes := make([]string, 0, len(config.ParsingConfigOverrides))
+		for fileType := range config.ParsingConfigOverrides {
+			fileTypes = append(fileTypes, fileType)
+		}
+		sort.Strings(fileTypes)
+		for _, fileType := range fileTypes {
+			fmt.Printf("    %-6s %s\n", fileType, describeDocumentParser(config.ParsingConfigOverrides[fileType]))
+		}
+	}
+
+	if chunking := config.LayoutChunking(); chunking != nil {
+		fmt.Println("  Chunking: layout-based")
+		if chunking.ChunkSize > 0 {
+			fmt.Printf("    Chunk Size: %d tokens\n", chunking.ChunkSize)
+		}
+		fmt.Printf("    Include Ancestor Headings: %t\n", chunking.IncludeAncestorHeadings)
+	} else {
+		fmt.Println("  Chunking: disabled")
+	}
+}
+
+func describeDocumentParser(parsing *client.DocumentParsingConfig) string {
+	parser := parsing.Parser()
+	if parser == "" {
+		return "(not set, digital)"
+	}
+
+	options := []string{}
+	if parsing.OcrParsingConfig != nil && parsing.OcrParsingConfig.UseNativeText {
+		options = append(options, "native text")
+	}
+	if layout := parsing.LayoutParsingConfig; layout != nil {
+		if layout.EnableTableAnnotation {
+			options = append(options, "table annotation")
+		}
+		if layout.EnableImageAnnotation {
+			options = append(options, "image annotation")
+		}
+	}
+	if len(options) > 0 {
+		return fmt.Sprintf("%s (%s)", parser, strings.Join(options, ", "))
+	}
+	return parser
+}
+
+// outputTargetSites outputs website data store target sites in the specified format
+func outputTargetSites(sites []*client.TargetSite, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(sites, format)
+	case "yaml":
+		return outputYAML(sites)
+	default:
+		if len(sites) == 0 {
+			fmt.Println("No target sites found.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("%-45s %-8s %-6s %-12s %-12s %-20s\n", "URI PATTERN", "TYPE", "EXACT", "INDEXING", "VERIFIED", "ID")
+		fmt.Println("=" + strings.Repeat("=", 110))
+		for _, site := range sites {
+			exact := ""
+			if site.ExactMatch {
+				exact = "yes"
+			}
+			indexing := valueOrPlaceholder(site.IndexingStatus)
+			if site.FailureReason != "" {
+				indexing = fmt.Sprintf("%s (%s)", indexing, site.FailureReason)
+			}
+			fmt.Printf("%-45s %-8s %-6s %-12s %-12s %-20s\n",
+				truncateString(site.URIPattern, 45), site.Type, exact,
+				truncateString(indexing, 12), truncateString(valueOrPlaceholder(site.VerificationState), 12),
+				truncateString(site.ID, 20))
+		}
+		fmt.Printf("\nTotal: %d target site(s)\n", len(sites))
+		return nil
+	}
+}
+
+// outputRecrawlStatus outputs the progress of a recrawl operation
+func outputRecrawlStatus(status *client.RecrawlStatus, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(status, format)
+	case "yaml":
+		return outputYAML(status)
+	default:
+		state := "RUNNING"
+		if status.Done {
+			state = "DONE"
+			if status.Error != "" {
+				state = "FAILED"
+			}
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Recrawl Operation: %s\n", status.Operation)
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("State: %s\n", state)
+		if status.Error != "" {
+			fmt.Printf("Error: %s\n", status.Error)
+		}
+		fmt.Printf("Valid URIs: %d\n", status.ValidURIs)
+		fmt.Printf("Succeeded: %d\n", status.SuccessCount)
+		fmt.Printf("Pending: %d\n", status.PendingCount)
+		if status.QuotaExceededCount > 0 {
+			fmt.Printf("Quota Exceeded: %d\n", status.QuotaExceededCount)
+		}
+
+		lists := []struct {
+			title string
+			uris  []string
+		}{
+			{"Failed URIs", status.FailedURIs},
+			{"Invalid URIs", status.InvalidURIs},
+			{"Noindex URIs", status.NoindexURIs},
+			{"URIs Not Matching Target Sites", status.URIsNotMatchingTargetSites},
+		}
+		for _, list := range lists {
+			if len(list.uris) == 0 {
+				continue
+			}
+			fmt.Printf("\n%s:\n", list.title)
+			for _, uri := range list.uris {
+				fmt.Printf("  - %s\n", uri)
+			}
+		}
+		return nil
+	}
+}
+
+// outputCompletionImportResult outputs the result of a deny-list or suggestion import
+func outputCompletionImportResult(result *client.CompletionImportResult, what, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		fmt.Printf("✅ Imported %d %s", result.ImportedCount, what)
+		if result.FailedCount > 0 {
+			fmt.Printf(" (%d failed)", result.FailedCount)
+		}
+		fmt.Println()
+		fmt.Printf("Operation: %s\n", result.Operation)
+		if len(result.ErrorSamples) > 0 {
+			fmt.Println("\nError samples:")
+			for _, sample := range result.ErrorSamples {
+				fmt.Printf("  - %s\n", sample)
+			}
+		}
+		return nil
+	}
+}
+
+// outputCompletionPurgeResult outputs the result of a deny-list or suggestion purge
+func outputCompletionPurgeResult(result *client.CompletionPurgeResult, what, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		if result.PurgedCount > 0 {
+			fmt.Printf("✅ Purged %d %s\n", result.PurgedCount, what)
+		} else {
+			fmt.Printf("✅ Purged %s\n", what)
+		}
+		fmt.Printf("Operation: %s\n", result.Operation)
+		if len(result.ErrorSamples) > 0 {
+			fmt.Println("\nError samples:")
+			for _, sample := range result.ErrorSamples {
+				fmt.Printf("  - %s\n", sample)
+			}
+		}
+		return nil
+	}
+}
+
+// outputCompletionQueryResult outputs the suggestions served for a prefix
+func outputCompletionQueryResult(result *client.CompletionQueryResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		header := fmt.Sprintf("Suggestions for %q", result.Query)
+		if result.TailMatchTriggered {
+			header += " (tail match)"
+		}
+		fmt.Println(header)
+		if len(result.Suggestions) == 0 {
+			fmt.Println("  (no suggestions)")
+			return nil
+		}
+		for i, suggestion := range result.Suggestions {
+			fmt.Printf("  %2d. %s", i+1, suggestion.Suggestion)
+			if len(suggestion.CompletableFieldPaths) > 0 {
+				fmt.Printf("  [%s]", strings.Join(suggestion.CompletableFieldPaths, ", "))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+}
+
+// outputUserEventResults outputs per-event results of a user event write
+func outputUserEventResults(results []client.UserEventResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(results, format)
+	case "yaml":
+		return outputYAML(results)
+	default:
+		counts := map[string]int{}
+		fmt.Printf("%-6s %-20s %-24s %-8s %s\n", "LINE", "EVENT TYPE", "USER PSEUDO ID", "STATUS", "ERROR")
+		fmt.Println(strings.Repeat("-", 100))
+		for _, result := range results {
+			counts[result.Status]++
+			fmt.Printf("%-6d %-20s %-24s %-8s %s\n",
+				result.Line,
+				truncateString(result.EventType, 20),
+				truncateString(result.UserPseudoID, 24),
+				result.Status,
+				result.Error,
+			)
+		}
+
+		summary := []string{}
+		for _, status := range []string{"success", "valid", "invalid", "error"} {
+			if counts[status] > 0 {
+				summary = append(summary, fmt.Sprintf("%d %s", counts[status], status))
+			}
+		}
+		fmt.Printf("\n%d event(s): %s\n", len(results), strings.Join(summary, ", "))
+		return nil
+	}
+}
+
+// outputUserEventValidation outputs the result of validating user events locally
+func outputUserEventValidation(validCount int, invalid []client.UserEventResult, format string) error {
+	switch format {
+	case "json", "yaml":
+		summary := map[string]interface{}{
+			"valid":   validCount,
+			"invalid": invalid,
+		}
+		if format == "json" {
+			return outputJSON(summary, format)
+		}
+		return outputYAML(summary)
+	default:
+		fmt.Printf("%d valid, %d invalid event(s).\n", validCount, len(invalid))
+		for _, result := range invalid {
+			fmt.Printf("  line %d (%s): %s\n", result.Line, valueOrPlaceholder(result.EventType), result.Error)
+		}
+		fmt.Println("Dry run complete. No events sent.")
+		return nil
+	}
+}
+
+// outputUserEventImportResult outputs the result of a user event import
+func outputUserEventImportResult(result *client.UserEventImportResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		fmt.Printf("✅ Imported %d user event(s)", result.ImportedCount)
+		if result.FailedCount > 0 {
+			fmt.Printf(" (%d failed)", result.FailedCount)
+		}
+		fmt.Println()
+		if result.JoinedCount > 0 || result.UnjoinedCount > 0 {
+			fmt.Printf("Joined with documents: %d, unjoined: %d\n", result.JoinedCount, result.UnjoinedCount)
+		}
+		for _, operation := range result.Operations {
+			fmt.Printf("Operation: %s\n", operation)
+		}
+		if len(result.ErrorSamples) > 0 {
+			fmt.Println("\nError samples:")
+			for _, sample := range result.ErrorSamples {
+				fmt.Printf("  - %s\n", sample)
+			}
+		}
+		if len(result.Invalid) > 0 {
+			fmt.Printf("\nSkipped %d invalid event(s):\n", len(result.Invalid))
+			for _, invalid := range result.Invalid {
+				fmt.Printf("  line %d (%s): %s\n", invalid.Line, valueOrPlaceholder(invalid.EventType), invalid.Error)
+			}
+		}
+		return nil
+	}
+}
+
+// outputUserEventPurgeResult outputs the result of a user event purge
+func outputUserEventPurgeResult(result *client.UserEventPurgeResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		fmt.Printf("✅ Purged %d user event(s) matching %s\n", result.PurgedCount, result.Filter)
+		fmt.Printf("Operation: %s\n", result.Operation)
+		return nil
+	}
+}
+
+// outputEvalReport outputs an evaluation report for one serving config
+func outputEvalReport(report *client.EvalReport, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(report, format)
+	case "yaml":
+		return outputYAML(report)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("Evaluation: %s (k=%d)\n", evalTargetLabel(report.ServingConfig), report.K)
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("Queries: %d", report.QueryCount)
+		if report.ErrorCount > 0 {
+			fmt.Printf(" (%d failed, excluded from the mean)", report.ErrorCount)
+		}
+		fmt.Println()
+		fmt.Printf("Precision@%d: %.3f\n", report.K, report.Mean.Precision)
+		fmt.Printf("Recall@%d:    %.3f\n", report.K, report.Mean.Recall)
+		fmt.Printf("MRR:          %.3f\n", report.Mean.MRR)
+		fmt.Printf("nDCG@%d:      %.3f\n", report.K, report.Mean.NDCG)
+
+		fmt.Println()
+		fmt.Printf("%-50s %-8s %-8s %-8s %-8s\n", "QUERY", "P@K", "R@K", "RR", "NDCG")
+		fmt.Println(strings.Repeat("-", 86))
+		for _, result := range report.Queries {
+			if result.Error != "" {
+				fmt.Printf("%-50s error: %s\n", truncateString(result.Query, 50), result.Error)
+				continue
+			}
+			fmt.Printf("%-50s %-8.3f %-8.3f %-8.3f %-8.3f\n",
+				truncateString(result.Query, 50),
+				result.Metrics.Precision,
+				result.Metrics.Recall,
+				result.Metrics.MRR,
+				result.Metrics.NDCG,
+			)
+		}
+		return nil
+	}
+}
+
+// outputEvalComparison outputs a side-by-side diff of two evaluation reports
+func outputEvalComparison(comparison *client.EvalComparison, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(comparison, format)
+	case "yaml":
+		return outputYAML(comparison)
+	default:
+		baseline, candidate := comparison.Baseline, comparison.Candidate
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("Evaluation comparison (k=%d, %d queries)\n", comparison.K, baseline.QueryCount)
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("Baseline:  %s\n", evalTargetLabel(baseline.ServingConfig))
+		fmt.Printf("Candidate: %s\n", evalTargetLabel(candidate.ServingConfig))
+		if baseline.ErrorCount > 0 || candidate.ErrorCount > 0 {
+			fmt.Printf("Failed queries: baseline %d, candidate %d\n", baseline.ErrorCount, candidate.ErrorCount)
+		}
+
+		fmt.Println()
+		fmt.Printf("%-14s %-10s %-10s %s\n", "METRIC", "BASELINE", "CANDIDATE", "DELTA")
+		fmt.Println(strings.Repeat("-", 50))
+		rows := []struct {
+			name                       string
+			baseline, candidate, delta float64
+		}{
+			{fmt.Sprintf("Precision@%d", comparison.K), baseline.Mean.Precision, candidate.Mean.Precision, comparison.Delta.Precision},
+			{fmt.Sprintf("Recall@%d", comparison.K), baseline.Mean.Recall, candidate.Mean.Recall, comparison.Delta.Recall},
+			{"MRR", baseline.Mean.MRR, candidate.Mean.MRR, comparison.Delta.MRR},
+			{fmt.Sprintf("nDCG@%d", comparison.K), baseline.Mean.NDCG, candidate.Mean.NDCG, comparison.Delta.NDCG},
+		}
+		for _, row := range rows {
+			fmt.Printf("%-14s %-10.3f %-10.3f %+.3f\n", row.name, row.baseline, row.candidate, row.delta)
+		}
+
+		fmt.Printf("\nQueries: %d improved, %d regressed, %d unchanged (by nDCG)\n",
+			comparison.Improved, comparison.Regressed, comparison.Unchanged)
+
+		changed := 0
+		for _, entry := range comparison.Queries {
+			if entry.Delta.NDCG == 0 {
+				continue
+			}
+			if changed == 0 {
+				fmt.Println()
+				fmt.Printf("%-50s %-10s %-10s %s\n", "QUERY", "BASELINE", "CANDIDATE", "DELTA")
+				fmt.Println(strings.Repeat("-", 86))
+			}
+			changed++
+			fmt.Printf("%-50s %-10.3f %-10.3f %+.3f\n",
+				truncateString(entry.Query, 50), entry.Baseline.NDCG, entry.Candidate.NDCG, entry.Delta.NDCG)
+		}
+		return nil
+	}
+}
+
+// outputEvaluations outputs the quality metrics of platform evaluations side by side
+func outputEvaluations(evaluations []*client.Evaluation, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(evaluations, format)
+	case "yaml":
+		return outputYAML(evaluations)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Println("Platform evaluation")
+		fmt.Println("=" + strings.Repeat("=", 100))
+		for i, evaluation := range evaluations {
+			fmt.Printf("[%d] %s\n", i+1, evaluation.Name)
+			fmt.Printf("    Serving config: %s\n", evalTargetLabel(evaluation.EvaluationSpec.SearchRequest.ServingConfig))
+			fmt.Printf("    State: %s\n", evaluation.State)
+		}
+
+		metrics := []struct {
+			name string
+			get  func(*client.EvaluationQualityMetrics) *client.EvaluationTopK
+		}{
+			{"docRecall", func(m *client.EvaluationQualityMetrics) *client.EvaluationTopK { return m.DocRecall }},
+			{"docPrecision", func(m *client.EvaluationQualityMetrics) *client.EvaluationTopK { return m.DocPrecision }},
+			{"docNdcg", func(m *client.EvaluationQualityMetrics) *client.EvaluationTopK { return m.DocNdcg }},
+			{"pageRecall", func(m *client.EvaluationQualityMetrics) *client.EvaluationTopK { return m.PageRecall }},
+			{"pageNdcg", func(m *client.EvaluationQualityMetrics) *client.EvaluationTopK { return m.PageNdcg }},
+		}
+
+		fmt.Println()
+		fmt.Printf("%-14s %-4s", "METRIC", "K")
+		for i := range evaluations {
+			fmt.Printf(" %-10s", fmt.Sprintf("[%d]", i+1))
+		}
+		if len(evaluations) == 2 {
+			fmt.Print(" DELTA")
+		}
+		fmt.Println()
+		fmt.Println(strings.Repeat("-", 60))
+		for _, metric := range metrics {
+			for _, cutoff := range []int{1, 3, 5, 10} {
+				values := []float64{}
+				present := false
+				for _, evaluation := range evaluations {
+					value := 0.0
+					if evaluation.QualityMetrics != nil {
+						if topK := metric.get(evaluation.QualityMetrics); topK != nil {
+							present = true
+							value = map[int]float64{1: topK.Top1, 3: topK.Top3, 5: topK.Top5, 10: topK.Top10}[cutoff]
+						}
+					}
+					values = append(values, value)
+				}
+				if !present {
+					continue
+				}
+				fmt.Printf("%-14s %-4d", metric.name, cutoff)
+				for _, value := range values {
+					fmt.Printf(" %-10.3f", value)
+				}
+				if len(values) == 2 {
+					fmt.Printf(" %+.3f", values[1]-values[0])
+				}
+				fmt.Println()
+			}
+		}
+		return nil
+	}
+}
+
+// outputSampleQuerySets outputs platform sample query sets
+func outputSampleQuerySets(sets []*client.SampleQuerySet, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(sets, format)
+	case "yaml":
+		return outputYAML(sets)
+	default:
+		if len(sets) == 0 {
+			fmt.Println("No sample query sets found.")
+			return nil
+		}
+		fmt.Printf("%-30s %-30s %s\n", "ID", "DISPLAY NAME", "CREATED")
+		fmt.Println(strings.Repeat("-", 90))
+		for _, set := range sets {
+			fmt.Printf("%-30s %-30s %s\n",
+				truncateString(extractResourceID(set.Name), 30),
+				truncateString(set.DisplayName, 30),
+				set.CreateTime,
+			)
+		}
+		return nil
+	}
+}
+
+// outputGroundingCheck outputs the support score and claim citations of a grounding check
+func outputGroundingCheck(result *client.GroundingCheckResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(result, format)
+	case "yaml":
+		return outputYAML(result)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Support score: %.3f\n", result.SupportScore)
+		fmt.Println("=" + strings.Repeat("=", 80))
+
+		for i, claim := range result.Claims {
+			fmt.Printf("[%d] %s\n", i+1, claim.Text)
+			if !claim.GroundingCheckRequired {
+				fmt.Println("    Grounding check not required")
+				continue
+			}
+			if claim.Score > 0 {
+				fmt.Printf("    Score: %.3f\n", claim.Score)
+			}
+			if len(claim.Citations) == 0 {
+				fmt.Println("    Citations: none")
+				continue
+			}
+			citations := []string{}
+			for _, index := range claim.Citations {
+				citations = append(citations, fmt.Sprintf("[%d]", index))
+			}
+			fmt.Printf("    Citations: %s\n", strings.Join(citations, " "))
+		}
+
+		if len(result.CitedChunks) > 0 {
+			fmt.Println()
+			fmt.Println("Cited facts:")
+			for _, chunk := range result.CitedChunks {
+				fmt.Printf("  [%d] %s\n", chunk.Index, truncateString(strings.Join(strings.Fields(chunk.Text), " "), 100))
+				if chunk.Source != "" {
+					fmt.Printf("      Fact: %s\n", chunk.Source)
+				}
+				for key, value := range chunk.Attributes {
+					fmt.Printf("      %s: %s\n", key, value)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// outputRankedRecords outputs records ordered by ranking score
+func outputRankedRecords(records []client.RankingRecord, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(records, format)
+	case "yaml":
+		return outputYAML(records)
+	default:
+		fmt.Printf("%-5s %-8s %-30s %s\n", "RANK", "SCORE", "ID", "TITLE")
+		fmt.Println(strings.Repeat("-", 100))
+		for i, record := range records {
+			title := record.Title
+			if title == "" {
+				title = strings.Join(strings.Fields(record.Content), " ")
+			}
+			if title == "" {
+				title = "-"
+			}
+			fmt.Printf("%-5d %-8.4f %-30s %s\n",
+				i+1,
+				record.Score,
+				truncateString(record.ID, 30),
+				truncateString(title, 55),
+			)
+		}
+		return nil
+	}
+}
+
+// outputSessions outputs a list of sessions
+func outputSessions(sessions []*client.Session, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(sessions, format)
+	case "yaml":
+		return outputYAML(sessions)
+	default:
+		if len(sessions) == 0 {
+			fmt.Println("No sessions found.")
+			return nil
+		}
+		fmt.Printf("%-22s %-24s %-12s %-6s %-22s %s\n", "ID", "USER PSEUDO ID", "STATE", "TURNS", "STARTED", "FIRST QUERY")
+		fmt.Println(strings.Repeat("-", 120))
+		for _, session := range sessions {
+			firstQuery := ""
+			if len(session.Turns) > 0 {
+				firstQuery = strings.Join(strings.Fields(session.Turns[0].Query), " ")
+			}
+			fmt.Printf("%-22s %-24s %-12s %-6d %-22s %s\n",
+				truncateString(extractResourceID(session.Name), 22),
+				truncateString(session.UserPseudoID, 24),
+				session.State,
+				len(session.Turns),
+				truncateString(session.StartTime, 22),
+				truncateString(firstQuery, 40),
+			)
+		}
+		fmt.Printf("\n%d session(s)\n", len(sessions))
+		return nil
+	}
+}
+
+// outputSessionDetails outputs a session and its turns
+func outputSessionDetails(session *client.Session, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(session, format)
+	case "yaml":
+		return outputYAML(session)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Session: %s\n", extractResourceID(session.Name))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Name: %s\n", session.Name)
+		fmt.Printf("Display Name: %s\n", valueOrPlaceholder(session.DisplayName))
+		fmt.Printf("User Pseudo ID: %s\n", valueOrPlaceholder(session.UserPseudoID))
+		fmt.Printf("State: %s\n", valueOrPlaceholder(session.State))
+		fmt.Printf("Started: %s\n", valueOrPlaceholder(session.StartTime))
+		fmt.Printf("Ended: %s\n", valueOrPlaceholder(session.EndTime))
+		if session.IsPinned {
+			fmt.Println("Pinned: true")
+		}
+		if len(session.Labels) > 0 {
+			fmt.Printf("Labels: %s\n", strings.Join(session.Labels, ", "))
+		}
 
-	if len(agent.Labels) > 0 {
-		fmt.Println("\nLabels:")
-		for key, value := range agent.Labels {
-			fmt.Printf("  %s: %s\n", key, value)
+		if len(session.Turns) == 0 {
+			fmt.Println("\nTurns: none")
+			return nil
+		}
+		fmt.Printf("\nTurns (%d):\n", len(session.Turns))
+		for i, turn := range session.Turns {
+			fmt.Printf("[%d] Q: %s\n", i+1, valueOrPlaceholder(turn.Query))
+			if turn.AnswerText != "" {
+				fmt.Printf("    A: %s\n", strings.ReplaceAll(strings.TrimSpace(turn.AnswerText), "\n", "\n       "))
+			} else if turn.Answer != "" {
+				fmt.Printf("    Answer: %s\n", extractResourceID(turn.Answer))
+			}
 		}
+		return nil
 	}
+}
 
-	if len(agent.Annotations) > 0 {
-		fmt.Println("\nAnnotations:")
-		for key, value := range agent.Annotations {
-			fmt.Printf("  %s: %s\n", key, value)
+// outputSessionDeleteResults outputs the outcome of deleting sessions
+func outputSessionDeleteResults(results []client.SessionDeleteResult, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(results, format)
+	case "yaml":
+		return outputYAML(results)
+	default:
+		deleted := 0
+		for _, result := range results {
+			if result.Status == "error" {
+				fmt.Printf("❌ %s: %s\n", extractResourceID(result.Name), result.Error)
+				continue
+			}
+			deleted++
 		}
+		fmt.Printf("✅ Deleted %d of %d session(s)\n", deleted, len(results))
+		return nil
 	}
+}
 
-	if agent.CreateTime != "" {
-		fmt.Printf("\nCreated: %s\n", agent.CreateTime)
-	}
-	if agent.UpdateTime != "" {
-		fmt.Printf("Updated: %s\n", agent.UpdateTime)
+// outputLicenseConfigs outputs subscriptions with their seat usage
+func outputLicenseConfigs(usage *client.LicenseUsage, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(usage, format)
+	case "yaml":
+		return outputYAML(usage)
+	default:
+		fmt.Printf("User store: %s\n\n", extractResourceID(usage.UserStore))
+		if len(usage.Configs) == 0 {
+			fmt.Println("No license configs found.")
+			return nil
+		}
+		printLicenseConfigTable(usage.Configs)
+		return nil
 	}
-
-	return nil
 }
 
-// outputEngineFeatures outputs engine features in the specified format.
-func outputEngineFeatures(engine *client.Engine, format string) error {
-	if engine == nil {
-		return fmt.Errorf("engine is nil")
+func printLicenseConfigTable(configs []*client.LicenseConfig) {
+	fmt.Printf("%-26s %-22s %-12s %-12s %-23s %-6s %-8s %s\n",
+		"LICENSE CONFIG", "TIER", "TERM", "STATE", "PERIOD", "SEATS", "ASSIGNED", "AVAILABLE")
+	fmt.Println(strings.Repeat("-", 125))
+	for _, config := range configs {
+		id := extractResourceID(config.Name)
+		if config.IsDefault {
+			id += " *"
+		}
+		fmt.Printf("%-26s %-22s %-12s %-12s %-23s %-6d %-8d %d\n",
+			truncateString(id, 26),
+			truncateString(strings.TrimPrefix(config.SubscriptionTier, "SUBSCRIPTION_TIER_"), 22),
+			strings.TrimPrefix(config.SubscriptionTerm, "SUBSCRIPTION_TERM_"),
+			config.State,
+			config.StartDate+" - "+config.EndDate,
+			config.LicenseCount,
+			config.AssignedCount,
+			config.AvailableCount,
+		)
 	}
+	fmt.Println("\n* default license config of the user store")
+}
 
+// outputUserLicenses outputs the users of a user store and their licenses
+func outputUserLicenses(users []*client.UserLicense, format string) error {
 	switch format {
 	case "json":
-		return outputJSON(engine.Features, format)
+		return outputJSON(users, format)
 	case "yaml":
-		return outputYAML(engine.Features)
+		return outputYAML(users)
 	default:
-		return outputEngineFeaturesTable(engine)
-	}
-}
+		if len(users) == 0 {
+			fmt.Println("No users found.")
+			return nil
+		}
+		fmt.Printf("%-36s %-28s %-26s %s\n", "USER", "STATE", "LICENSE CONFIG", "LAST LOGIN")
+		fmt.Println(strings.Repeat("-", 115))
+		counts := map[string]int{}
+		for _, user := range users {
+			counts[user.State]++
+			fmt.Printf("%-36s %-28s %-26s %s\n",
+				truncateString(user.UserPrincipal, 36),
+				user.State,
+				truncateString(extractResourceID(user.LicenseConfig), 26),
+				user.LastLoginTime,
+			)
+		}
 
-func outputEngineFeaturesTable(engine *client.Engine) error {
-	features := engine.Features
-	if len(features) == 0 {
-		fmt.Println("No feature configuration found for this engine.")
+		states := make([]string, 0, len(counts))
+		for state := range counts {
+			states = append(states, state)
+		}
+		sort.Strings(states)
+		summary := []string{}
+		for _, state := range states {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[state], state))
+		}
+		fmt.Printf("\n%d user(s): %s\n", len(users), strings.Join(summary, ", "))
 		return nil
 	}
+}
 
-	keys := sortedFeatureKeys(features)
-	enabled := 0
-
-	fmt.Println("=" + strings.Repeat("=", 80))
-	fmt.Printf("Features for engine: %s\n", engine.DisplayName)
-	fmt.Println("=" + strings.Repeat("=", 80))
-	fmt.Printf("%-40s %-20s\n", "FEATURE", "STATE")
-	fmt.Println("-" + strings.Repeat("-", 80))
+// outputLicenseUpdatePlan outputs the users a bulk assign or unassign would change
+func outputLicenseUpdatePlan(plan *client.LicenseUpdatePlan, action, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(plan, format)
+	case "yaml":
+		return outputYAML(plan)
+	default:
+		reassigned := map[string]bool{}
+		for _, principal := range plan.Reassigned {
+			reassigned[principal] = true
+		}
+		for _, principal := range plan.Update {
+			if reassigned[principal] {
+				fmt.Printf("  ~ %s (moves from another license)\n", principal)
+			} else {
+				fmt.Printf("  + %s\n", principal)
+			}
+		}
 
-	for _, key := range keys {
-		state := features[key]
-		if strings.Contains(state, "ON") {
-			enabled++
+		fmt.Printf("\n%d user(s) to %s", len(plan.Update), action)
+		if len(plan.Unchanged) > 0 {
+			reason := "already licensed"
+			if action == "unassign" {
+				reason = "without a license"
+			}
+			fmt.Printf(", %d skipped (%s)", len(plan.Unchanged), reason)
 		}
-		fmt.Printf("%-40s %-20s\n", key, renderFeatureState(state))
+		fmt.Println()
+		return nil
 	}
-
-	fmt.Printf("\nEnabled: %d/%d\n", enabled, len(features))
-	return nil
 }
 
-// outputDataStores outputs data stores in the specified format
-func outputDataStores(dataStores []*client.DataStore, format string) error {
+// outputLicenseUpdateResult outputs the outcome of a bulk assign or unassign
+func outputLicenseUpdateResult(result *client.LicenseUpdateResult, verb, format string) error {
 	switch format {
 	case "json":
-		return outputJSON(dataStores, format)
+		return outputJSON(result, format)
 	case "yaml":
-		return outputYAML(dataStores)
+		return outputYAML(result)
 	default:
-		return outputDataStoresTable(dataStores)
+		fmt.Printf("✅ %s %d license(s)", verb, result.UpdatedCount)
+		if result.FailedCount > 0 {
+			fmt.Printf(", %d failed", result.FailedCount)
+		}
+		fmt.Println()
+		for _, sample := range result.ErrorSamples {
+			fmt.Printf("  - %s\n", sample)
+		}
+		return nil
 	}
 }
 
-// outputDataStoresTable outputs data stores in table format
-func outputDataStoresTable(dataStores []*client.DataStore) error {
-	if len(dataStores) == 0 {
-		fmt.Println("No data stores found.")
+// outputUnusedSeats outputs available seats and inactive licensed users
+func outputUnusedSeats(report *client.UnusedSeatsReport, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(report, format)
+	case "yaml":
+		return outputYAML(report)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Unused seats in %s\n", extractResourceID(report.UserStore))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		if len(report.Configs) > 0 {
+			printLicenseConfigTable(report.Configs)
+			fmt.Println()
+		}
+		fmt.Printf("Available seats in active subscriptions: %d\n", report.AvailableSeats)
+		fmt.Printf("Licensed users inactive for %d+ days: %d\n", report.InactiveDays, len(report.InactiveUsers))
+
+		if len(report.InactiveUsers) > 0 {
+			fmt.Println()
+			fmt.Printf("%-36s %-26s %s\n", "USER", "LICENSE CONFIG", "LAST LOGIN")
+			fmt.Println(strings.Repeat("-", 90))
+			for _, user := range report.InactiveUsers {
+				lastLogin := user.LastLoginTime
+				if lastLogin == "" {
+					lastLogin = "never"
+				}
+				fmt.Printf("%-36s %-26s %s\n",
+					truncateString(user.UserPrincipal, 36),
+					truncateString(extractResourceID(user.LicenseConfig), 26),
+					lastLogin,
+				)
+			}
+		}
 		return nil
 	}
+}
 
-	fmt.Println("=" + strings.Repeat("=", 100))
-	fmt.Printf("%-50s %-30s %-20s\n", "NAME", "DISPLAY NAME", "CONTENT CONFIG")
-	fmt.Println("=" + strings.Repeat("=", 100))
-
-	for _, ds := range dataStores {
-		name := extractResourceID(ds.Name)
-		displayName := ds.DisplayName
-		contentConfig := ds.ContentConfig
-
-		fmt.Printf("%-50s %-30s %-20s\n", name, displayName, contentConfig)
+// outputDataConnectors outputs a list of data connectors
+func outputDataConnectors(connectors []*client.DataConnector, format string) error {
+	redacted := make([]*client.DataConnector, 0, len(connectors))
+	for _, connector := range connectors {
+		redacted = append(redacted, connector.Redacted())
 	}
 
-	fmt.Printf("\nTotal: %d data store(s)\n", len(dataStores))
-	return nil
-}
-
-// outputDataStoreDetails outputs data store details in the specified format
-func outputDataStoreDetails(dataStore *client.DataStore, format string) error {
 	switch format {
 	case "json":
-		return outputJSON(dataStore, format)
+		return outputJSON(redacted, format)
 	case "yaml":
-		return outputYAML(dataStore)
+		return outputYAML(redacted)
 	default:
-		return outputDataStoreDetailsTable(dataStore)
+		if len(connectors) == 0 {
+			fmt.Println("No data connectors found.")
+			return nil
+		}
+
+		fmt.Println("=" + strings.Repeat("=", 110))
+		fmt.Printf("%-25s %-15s %-10s %-8s %-12s %-25s\n", "COLLECTION", "SOURCE", "STATE", "PAUSED", "REFRESH", "LAST SYNC")
+		fmt.Println("=" + strings.Repeat("=", 110))
+		for _, connector := range connectors {
+			paused := ""
+			if connector.AutoRunDisabled {
+				paused = "yes"
+			}
+			fmt.Printf("%-25s %-15s %-10s %-8s %-12s %-25s\n",
+				truncateString(extractResourceID(connector.CollectionName()), 25),
+				truncateString(connector.DataSource, 15),
+				truncateString(valueOrPlaceholder(connector.State), 10), paused,
+				truncateString(connector.RefreshInterval, 12),
+				truncateString(valueOrPlaceholder(connector.LastSyncTime), 25))
+		}
+		fmt.Printf("\nTotal: %d connector(s)\n", len(connectors))
+		return nil
 	}
 }
 
-// outputDataStoreDetailsTable outputs data store details in table format
-func outputDataStoreDetailsTable(dataStore *client.DataStore) error {
-	fmt.Println("=" + strings.Repeat("=", 80))
-	fmt.Printf("Data Store: %s\n", dataStore.DisplayName)
-	fmt.Println("=" + strings.Repeat("=", 80))
-	fmt.Printf("Name: %s\n", dataStore.Name)
-	fmt.Printf("Industry Vertical: %s\n", dataStore.IndustryVertical)
-	fmt.Printf("Content Config: %s\n", dataStore.ContentConfig)
-	fmt.Printf("Created: %s\n", dataStore.CreateTime)
-
-	if len(dataStore.SolutionTypes) > 0 {
-		fmt.Printf("Solution Types: %s\n", strings.Join(dataStore.SolutionTypes, ", "))
-	}
+// outputDataConnectorDetails outputs a data connector's configuration and status
+func outputDataConnectorDetails(connector *client.DataConnector, format string) error {
+	connector = connector.Redacted()
 
-	if dataStore.AclEnabled {
-		fmt.Printf("ACL Enabled: %t\n", dataStore.AclEnabled)
-	}
+	switch format {
+	case "json":
+		return outputJSON(connector, format)
+	case "yaml":
+		return outputYAML(connector)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Connector: %s\n", extractResourceID(connector.CollectionName()))
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Name: %s\n", connector.Name)
+		fmt.Printf("Data Source: %s\n", connector.DataSource)
+		fmt.Printf("State: %s\n", valueOrPlaceholder(connector.State))
+		fmt.Printf("Sync Mode: %s\n", valueOrPlaceholder(connector.SyncMode))
+		fmt.Printf("Refresh Interval: %s\n", valueOrPlaceholder(connector.RefreshInterval))
+		fmt.Printf("Incremental Refresh Interval: %s\n", valueOrPlaceholder(connector.IncrementalRefreshInterval))
+		fmt.Printf("Scheduled Syncs Paused: %t\n", connector.AutoRunDisabled)
+		fmt.Printf("ACL Enabled: %t\n", connector.AclEnabled)
+		fmt.Printf("Last Sync: %s\n", valueOrPlaceholder(connector.LastSyncTime))
+		if connector.LatestPauseTime != "" {
+			fmt.Printf("Last Paused: %s\n", connector.LatestPauseTime)
+		}
+		fmt.Printf("Created: %s\n", valueOrPlaceholder(connector.CreateTime))
 
-	if dataStore.BillingEstimation != nil {
-		size := float64(dataStore.BillingEstimation.UnstructuredDataSize) / (1024 * 1024)
-		fmt.Printf("\nBilling Estimation:\n")
-		fmt.Printf("  Size: %.2f MB\n", size)
-		fmt.Printf("  Updated: %s\n", dataStore.BillingEstimation.UnstructuredDataUpdateTime)
-	}
+		if len(connector.Params) > 0 {
+			keys := make([]string, 0, len(connector.Params))
+			for key := range connector.Params {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			fmt.Println("\nParams:")
+			for _, key := range keys {
+				fmt.Printf("  %s: %v\n", key, connector.Params[key])
+			}
+		}
 
-	if dataStore.DocumentProcessingConfig != nil {
-		fmt.Println("\nDocument Processing:")
-		if chunkingConfig, ok := dataStore.DocumentProcessingConfig["chunkingConfig"].(map[string]interface{}); ok {
-			if layoutConfig, ok := chunkingConfig["layoutBasedChunkingConfig"].(map[string]interface{}); ok {
-				if chunkSize, ok := layoutConfig["chunkSize"].(string); ok {
-					fmt.Printf("  Chunk Size: %s\n", chunkSize)
-				}
+		if len(connector.Entities) > 0 {
+			fmt.Println("\nEntities:")
+			for _, entity := range connector.Entities {
+				fmt.Printf("  - %s -> %s\n", entity.EntityName, valueOrPlaceholder(extractResourceID(entity.DataStore)))
 			}
 		}
 
-		if defaultParsingConfig, ok := dataStore.DocumentProcessingConfig["defaultParsingConfig"].(map[string]interface{}); ok {
-			if layoutParsingConfig, ok := defaultParsingConfig["layoutParsingConfig"].(map[string]interface{}); ok {
-				if tableAnnotation, ok := layoutParsingConfig["enableTableAnnotation"].(bool); ok && tableAnnotation {
-					fmt.Println("  ✓ Table annotation enabled")
-				}
-				if imageAnnotation, ok := layoutParsingConfig["enableImageAnnotation"].(bool); ok && imageAnnotation {
-					fmt.Println("  ✓ Image annotation enabled")
-				}
+		if len(connector.BlockingReasons) > 0 {
+			fmt.Println("\nBlocking Reasons:")
+			for _, reason := range connector.BlockingReasons {
+				fmt.Printf("  - %s\n", reason)
+			}
+		}
+		if len(connector.Errors) > 0 {
+			fmt.Println("\nErrors:")
+			for _, connectorErr := range connector.Errors {
+				fmt.Printf("  - %s (code %d)\n", connectorErr.Message, connectorErr.Code)
 			}
 		}
+		return nil
 	}
+}
 
-	if dataStore.Schema != nil {
-		if schemaName, ok := dataStore.Schema["name"].(string); ok {
-			fmt.Printf("\nSchema: %s\n", schemaName)
+// outputConnectorRuns outputs connector sync runs with per-entity results
+func outputConnectorRuns(runs []*client.ConnectorRun, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(runs, format)
+	case "yaml":
+		return outputYAML(runs)
+	default:
+		if len(runs) == 0 {
+			fmt.Println("No connector runs found.")
+			return nil
 		}
-	}
 
-	return nil
+		fmt.Println("=" + strings.Repeat("=", 100))
+		fmt.Printf("%-22s %-12s %-15s %-25s %-25s\n", "RUN", "STATE", "TRIGGER", "STARTED", "ENDED")
+		fmt.Println("=" + strings.Repeat("=", 100))
+		for _, run := range runs {
+			fmt.Printf("%-22s %-12s %-15s %-25s %-25s\n",
+				truncateString(extractResourceID(run.Name), 22),
+				truncateString(run.State, 12),
+				truncateString(valueOrPlaceholder(run.Trigger), 15),
+				truncateString(valueOrPlaceholder(run.StartTime), 25),
+				truncateString(valueOrPlaceholder(run.EndTime), 25))
+			for _, entityRun := range run.EntityRuns {
+				fmt.Printf("    %-20s %-12s %-12s extracted=%d indexed=%d errors=%d deleted=%d\n",
+					truncateString(entityRun.EntityName, 20), truncateString(entityRun.State, 12),
+					truncateString(entityRun.SyncType, 12), entityRun.ExtractedRecordCount,
+					entityRun.IndexedRecordCount, entityRun.ErrorRecordCount, entityRun.DeletedRecordCount)
+				for _, entityErr := range entityRun.Errors {
+					fmt.Printf("      ! %s\n", entityErr.Message)
+				}
+			}
+			for _, runErr := range run.Errors {
+				fmt.Printf("    ! %s\n", runErr.Message)
+			}
+		}
+		fmt.Printf("\nTotal: %d run(s)\n", len(runs))
+		return nil
+	}
 }
 
 // outputDocuments outputs documents in the specified format
@@ -601,6 +2165,10 @@ func outputSnapshotDiff(diff client.SnapshotDiff, format string) error {
 	case "yaml":
 		return outputYAML(diff)
 	default:
+		for _, warning := range diff.Warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+		}
+
 		if diff.IsEmpty() {
 			fmt.Println("No differences found.")
 			return nil
@@ -644,6 +2212,45 @@ func outputSnapshotDiff(diff client.SnapshotDiff, format string) error {
 					fmt.Printf("  ? %s (%s)\n", change.Key, change.ChangeType)
 				}
 			}
+			fmt.Println()
+		}
+
+		if len(diff.AssistantChanges) > 0 {
+			fmt.Println("Assistant changes:")
+			for _, change := range diff.AssistantChanges {
+				fmt.Printf("  ~ %s\n", change.Field)
+			}
+			fmt.Println()
+		}
+
+		if len(diff.ControlChanges) > 0 {
+			fmt.Println("Control changes:")
+			for _, change := range diff.ControlChanges {
+				fmt.Printf("  ~ %s: %v -> %v\n", change.Field, change.Old, change.New)
+			}
+			fmt.Println()
+		}
+
+		if len(diff.ServingConfigChanges) > 0 {
+			fmt.Println("Serving config changes:")
+			for _, change := range diff.ServingConfigChanges {
+				fmt.Printf("  ~ %s\n", change.Field)
+			}
+			fmt.Println()
+		}
+
+		if len(diff.ConnectorChanges) > 0 {
+			fmt.Println("Connector changes (not applied by restore):")
+			for _, change := range diff.ConnectorChanges {
+				switch {
+				case change.Old == nil:
+					fmt.Printf("  + %s (added)\n", change.Field)
+				case change.New == nil:
+					fmt.Printf("  - %s (removed)\n", change.Field)
+				default:
+					fmt.Printf("  ~ %s\n", change.Field)
+				}
+			}
 		}
 		return nil
 	}
@@ -677,12 +2284,16 @@ func outputWorkforceIdentity(cfg *client.WorkforceIdentityConfig, format string)
 		fmt.Println("Workforce Identity Configuration")
 		fmt.Println("=" + strings.Repeat("=", 60))
 
-		if cfg == nil || (cfg.IdpType == "" && cfg.WorkforcePoolName == "") {
+		if cfg.EffectiveIdpType() == client.IdpTypeUnspecified && cfg.WorkforcePoolName == "" {
 			fmt.Println("Workforce identity is not configured for this project/location.")
 			return nil
 		}
 
-		fmt.Printf("IDP Type: %s\n", cfg.IdpType)
+		fmt.Printf("Identity Provider: %s\n", cfg.IdpTypeLabel())
+		if cfg.EffectiveIdpType() == client.IdpTypeGSuite {
+			fmt.Println("Users sign in with their Google (Cloud Identity / Workspace) accounts.")
+			return nil
+		}
 		fmt.Printf("Workforce Resource: %s\n", valueOrPlaceholder(cfg.WorkforcePoolName))
 		fmt.Printf("Workforce Location: %s\n", valueOrPlaceholder(cfg.WorkforceLocation))
 		fmt.Printf("Workforce ID: %s\n", valueOrPlaceholder(cfg.WorkforcePoolID))
@@ -691,6 +2302,131 @@ func outputWorkforceIdentity(cfg *client.WorkforceIdentityConfig, format string)
 	}
 }
 
+// outputWorkforceSetPlan outputs the current and proposed workforce configuration
+func outputWorkforceSetPlan(plan *workforceSetPlan, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(plan, format)
+	case "yaml":
+		return outputYAML(plan)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Println("Workforce Identity Dry Run")
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("%-22s %-28s %-28s\n", "FIELD", "CURRENT", "PROPOSED")
+		rows := [][3]string{
+			{"IDP Type", plan.Current.EffectiveIdpType(), plan.Proposed.EffectiveIdpType()},
+			{"Workforce Location", plan.Current.WorkforceLocation, plan.Proposed.WorkforceLocation},
+			{"Workforce ID", plan.Current.WorkforcePoolID, plan.Proposed.WorkforcePoolID},
+			{"Workforce Provider ID", plan.Current.WorkforceProvider, plan.Proposed.WorkforceProvider},
+		}
+		for _, row := range rows {
+			fmt.Printf("%-22s %-28s %-28s\n", row[0],
+				truncateString(valueOrPlaceholder(row[1]), 28), truncateString(valueOrPlaceholder(row[2]), 28))
+		}
+		fmt.Printf("\nProposed Resource: %s\n", valueOrPlaceholder(plan.Proposed.WorkforcePoolName))
+		if !plan.Changed {
+			fmt.Println("No change: this configuration is already in place.")
+		}
+		if len(plan.ACLDataStores) > 0 {
+			fmt.Printf("\nWarning: switching IdP type with ACL-enabled data stores requires --force: %s\n",
+				strings.Join(plan.ACLDataStores, ", "))
+		}
+
+		if plan.Validation != nil {
+			fmt.Println()
+			printValidationChecks(plan.Validation.Checks)
+		}
+		fmt.Println("\nDry run complete. No changes applied.")
+		return nil
+	}
+}
+
+// outputWorkforceValidationReport outputs workforce pool validation checks
+func outputWorkforceValidationReport(report *client.WorkforceValidationReport, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(report, format)
+	case "yaml":
+		return outputYAML(report)
+	default:
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Workforce Resource: %s\n", report.Resource)
+		fmt.Println("=" + strings.Repeat("=", 80))
+		printValidationChecks(report.Checks)
+		return nil
+	}
+}
+
+// outputWorkforcePoolDetails outputs a workforce pool and its providers
+func outputWorkforcePoolDetails(details *client.WorkforcePoolDetails, format string) error {
+	switch format {
+	case "json":
+		return outputJSON(details, format)
+	case "yaml":
+		return outputYAML(details)
+	default:
+		pool := details.Pool
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Workforce Pool: %s\n", pool.Name)
+		fmt.Println("=" + strings.Repeat("=", 80))
+		fmt.Printf("Display Name: %s\n", valueOrPlaceholder(pool.DisplayName))
+		if pool.Description != "" {
+			fmt.Printf("Description: %s\n", pool.Description)
+		}
+		fmt.Printf("State: %s\n", valueOrPlaceholder(pool.State))
+		fmt.Printf("Disabled: %t\n", pool.Disabled)
+		if pool.SessionDuration != "" {
+			fmt.Printf("Session Duration: %s\n", pool.SessionDuration)
+		}
+
+		if len(details.Providers) == 0 {
+			fmt.Println("\nNo providers configured.")
+			return nil
+		}
+
+		for _, provider := range details.Providers {
+			fmt.Println("\n" + strings.Repeat("-", 81))
+			fmt.Printf("Provider: %s\n", provider.Name)
+			fmt.Printf("Display Name: %s\n", valueOrPlaceholder(provider.DisplayName))
+			fmt.Printf("Protocol: %s\n", provider.Protocol())
+			fmt.Printf("State: %s\n", valueOrPlaceholder(provider.State))
+			fmt.Printf("Disabled: %t\n", provider.Disabled)
+			if provider.Oidc != nil {
+				fmt.Printf("Issuer URI: %s\n", valueOrPlaceholder(provider.Oidc.IssuerURI))
+				fmt.Printf("Client ID: %s\n", valueOrPlaceholder(provider.Oidc.ClientID))
+			}
+			if provider.AttributeCondition != "" {
+				fmt.Printf("Attribute Condition: %s\n", provider.AttributeCondition)
+			}
+
+			fmt.Println("Attribute Mapping:")
+			if len(provider.AttributeMapping) == 0 {
+				fmt.Println("  (none)")
+				continue
+			}
+			keys := make([]string, 0, len(provider.AttributeMapping))
+			for key := range provider.AttributeMapping {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Printf("  %-30s = %s\n", key, provider.AttributeMapping[key])
+			}
+		}
+		return nil
+	}
+}
+
+func printValidationChecks(checks []client.ValidationCheck) {
+	for _, check := range checks {
+		fmt.Printf("[%s] %-20s %s\n", check.Status, check.Name, check.Message)
+		if check.Fix != "" {
+			fmt.Printf("       fix: %s\n", check.Fix)
+		}
+	}
+}
+
 func valueOrPlaceholder(value string) string {
 	if strings.TrimSpace(value) == "" {
 		return "(not set)"
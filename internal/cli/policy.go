@@ -0,0 +1,307 @@
+package cli
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewPolicyCommand creates the policy command group
+func NewPolicyCommand() *cobra.Command {
+	policyCmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Evaluate policy-as-code rules against Gemini Enterprise resources",
+		Long: `Evaluate policy rules against engines, feature flags, agents, data stores, and the
+workforce identity configuration of a project and location.`,
+	}
+
+	policyCmd.AddCommand(NewPolicyCheckCommand())
+
+	return policyCmd
+}
+
+// NewPolicyCheckCommand creates the policy check command
+func NewPolicyCheckCommand() *cobra.Command {
+	var rulesPath string
+	var reportFormat string
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "check --rules FILE",
+		Short: "Check resources against policy rules",
+		Long: `Check resources against policy rules and exit non-zero when any error-severity
+rule is violated.
+
+Rules target engine, agent, datastore, or workforce resources. Each assert condition
+names a dot-separated field of the resource's JSON form and an op: equals, not-equals,
+present, absent, in, not-in, matches, not-matches, prefix, not-prefix. Engine
+features are exposed as features.<name> with the value ON or OFF. Rules can be
+limited with 'engines' and 'ids' glob patterns.
+
+  rules:
+    - id: no-session-sharing-in-prod
+      description: Session sharing must stay off in production
+      target: engine
+      engines: ["prod-*"]
+      assert:
+        - field: features.session-sharing
+          op: equals
+          value: "OFF"
+    - id: workforce-identity-required
+      target: workforce
+      assert:
+        - field: configured
+          op: equals
+          value: "true"
+    - id: no-external-agent-icons
+      target: agent
+      severity: warning
+      assert:
+        - field: icon.uri
+          op: absent
+
+Examples:
+  gemctl policy check --rules policy.yaml
+  gemctl policy check --rules policy.yaml --report sarif -o policy.sarif
+  gemctl policy check --rules policy.yaml --report junit -o policy-junit.xml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if rulesPath == "" {
+				return fmt.Errorf("--rules is required")
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rules, err := client.LoadPolicyRules(rulesPath)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			inventory, err := geminiClient.CollectPolicyInventory(rules)
+			if err != nil {
+				return fmt.Errorf("failed to collect resources: %w", err)
+			}
+
+			results := client.EvaluatePolicy(rules, inventory)
+
+			var out io.Writer = os.Stdout
+			if outputPath != "" {
+				file, err := os.Create(outputPath)
+				if err != nil {
+					return fmt.Errorf("failed to create report file: %w", err)
+				}
+				defer file.Close()
+				out = file
+			}
+
+			switch reportFormat {
+			case "sarif":
+				err = writePolicySARIF(out, rules, results)
+			case "junit":
+				err = writePolicyJUnit(out, results)
+			case "":
+				err = outputPolicyResults(results, config.Format)
+			default:
+				return fmt.Errorf("unknown report format %q (use sarif or junit)", reportFormat)
+			}
+			if err != nil {
+				return err
+			}
+
+			errorCount, warningCount := client.PolicyViolations(results)
+			if outputPath != "" {
+				fmt.Printf("Policy report written to %s (%d error(s), %d warning(s))\n", outputPath, errorCount, warningCount)
+			}
+			if errorCount > 0 {
+				return fmt.Errorf("%d policy violation(s) found", errorCount)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&rulesPath, "rules", "", "YAML file with policy rules (required)")
+	cmd.Flags().StringVar(&reportFormat, "report", "", "Report format: sarif or junit (default: --format output)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Path to write the report (default stdout)")
+
+	return cmd
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func writePolicySARIF(out io.Writer, rules []client.PolicyRule, results []client.PolicyResult) error {
+	driver := sarifDriver{Name: "gemctl-policy"}
+	targets := map[string]string{}
+	for _, rule := range rules {
+		description := rule.Description
+		if description == "" {
+			description = rule.ID
+		}
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+		targets[rule.ID] = rule.Target
+	}
+
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}
+	for _, result := range results {
+		if result.Passed {
+			continue
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  result.RuleID,
+			Level:   sarifLevel(result.Severity),
+			Message: sarifMessage{Text: result.Message},
+			Locations: []sarifLocation{{
+				LogicalLocations: []sarifLogicalLocation{{
+					FullyQualifiedName: result.Resource,
+					Kind:               targets[result.RuleID],
+				}},
+			}},
+		})
+	}
+
+	data, err := json.MarshalIndent(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode SARIF report: %w", err)
+	}
+	_, err = fmt.Fprintln(out, string(data))
+	return err
+}
+
+func sarifLevel(severity string) string {
+	if severity == client.PolicySeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func writePolicyJUnit(out io.Writer, results []client.PolicyResult) error {
+	suitesByRule := map[string]*junitTestSuite{}
+	ruleIDs := []string{}
+	report := junitTestSuites{}
+
+	for _, result := range results {
+		suite, ok := suitesByRule[result.RuleID]
+		if !ok {
+			suite = &junitTestSuite{Name: result.RuleID}
+			suitesByRule[result.RuleID] = suite
+			ruleIDs = append(ruleIDs, result.RuleID)
+		}
+
+		testCase := junitTestCase{Name: result.Resource, ClassName: result.RuleID}
+		if !result.Passed {
+			testCase.Failure = &junitFailure{Message: result.Message, Type: result.Severity, Text: result.Message}
+			suite.Failures++
+			report.Failures++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.Tests++
+		report.Tests++
+	}
+
+	sort.Strings(ruleIDs)
+	for _, ruleID := range ruleIDs {
+		report.Suites = append(report.Suites, *suitesByRule[ruleID])
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+	_, err = fmt.Fprintf(out, "%s%s\n", xml.Header, data)
+	return err
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/vb140772/gemctl-go/internal/client"
+)
+
+// NewRankCommand creates the rank command
+func NewRankCommand() *cobra.Command {
+	var query string
+	var recordsPath string
+	var opts client.RankOptions
+
+	cmd := &cobra.Command{
+		Use:   "rank --query QUERY --records FILE",
+		Short: "Rerank records against a query with the ranking API",
+		Long: `Score records against a query with the ranking API and print them reordered by
+relevance.
+
+The records file is a YAML or JSON list, or JSONL (.jsonl) with one record per line:
+
+  - id: doc-1
+    title: Pricing plans
+    content: Our plans start at ...
+
+Records without an id are numbered by position; each record needs a title or content.
+Use "-" to read records from standard input. Larger files are scored in batches of 200.
+
+Examples:
+  gemctl rank --query "how do I reset my password" --records candidates.yaml
+  gemctl rank --query "pricing" --records candidates.jsonl --top-n 5 --format=json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if query == "" || recordsPath == "" {
+				return fmt.Errorf("--query and --records are required")
+			}
+
+			data, err := readInputFile(recordsPath)
+			if err != nil {
+				return err
+			}
+			records, err := client.ParseRankingRecords(data, recordsPath)
+			if err != nil {
+				return err
+			}
+
+			config, err := getConfigFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			geminiClient, err := client.NewGeminiClient(config)
+			if err != nil {
+				return fmt.Errorf("failed to create client: %w", err)
+			}
+
+			ranked, err := geminiClient.RankRecords(query, records, opts)
+			if err != nil {
+				return err
+			}
+
+			return outputRankedRecords(ranked, config.Format)
+		},
+	}
+
+	cmd.Flags().StringVar(&query, "query", "", "Query to rank records against (required)")
+	cmd.Flags().StringVar(&recordsPath, "records", "", "File with records to rank, or - for stdin (required)")
+	cmd.Flags().IntVar(&opts.TopN, "top-n", 0, "Only return the N best records")
+	cmd.Flags().StringVar(&opts.Model, "model", "", "Ranking model (default: the service default, semantic-ranker-default@latest)")
+	cmd.Flags().BoolVar(&opts.IgnoreRecordText, "ids-only", false, "Return only record IDs and scores")
+
+	return cmd
+}
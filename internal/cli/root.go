@@ -31,6 +31,12 @@ Project: Set via --project, GOOGLE_CLOUD_PROJECT env var, or gcloud config.`,
 	// Add subcommands
 	rootCmd.AddCommand(NewEnginesCommand())
 	rootCmd.AddCommand(NewDataStoresCommand())
+	rootCmd.AddCommand(NewConnectorsCommand())
+	rootCmd.AddCommand(NewEvalCommand())
+	rootCmd.AddCommand(NewGroundingCommand())
+	rootCmd.AddCommand(NewRankCommand())
+	rootCmd.AddCommand(NewLicensesCommand())
+	rootCmd.AddCommand(NewPolicyCommand())
 
 	return rootCmd
 }
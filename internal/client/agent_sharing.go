@@ -0,0 +1,218 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	// AgentUserRole grants end users permission to discover and chat with an agent.
+	AgentUserRole = "roles/discoveryengine.agentUser"
+	// AgentEditorRole grants permission to edit an agent registration.
+	AgentEditorRole = "roles/discoveryengine.agentEditor"
+)
+
+// IamPolicy mirrors the IAM policy returned by the agent getIamPolicy endpoint.
+type IamPolicy struct {
+	Version  int           `json:"version,omitempty"`
+	Etag     string        `json:"etag,omitempty"`
+	Bindings []*IamBinding `json:"bindings,omitempty"`
+}
+
+// IamBinding associates a role with a list of members.
+type IamBinding struct {
+	Role      string                 `json:"role"`
+	Members   []string               `json:"members,omitempty"`
+	Condition map[string]interface{} `json:"condition,omitempty"`
+}
+
+// AgentAccessEntry is a flattened role/member pair used for access listings.
+type AgentAccessEntry struct {
+	Role   string `json:"role"`
+	Member string `json:"member"`
+}
+
+// GetAgentIamPolicy retrieves the IAM policy that controls who can use an agent.
+func (c *GeminiClient) GetAgentIamPolicy(agentName string) (*IamPolicy, error) {
+	url, err := c.agentResourceURL(agentName)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doAgentsRequest(http.MethodGet, url+":getIamPolicy", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &IamPolicy{}
+	if len(body) == 0 {
+		return policy, nil
+	}
+	if err := json.Unmarshal(body, policy); err != nil {
+		return nil, fmt.Errorf("failed to decode agent IAM policy: %w", err)
+	}
+	return policy, nil
+}
+
+// SetAgentIamPolicy replaces the IAM policy on an agent.
+func (c *GeminiClient) SetAgentIamPolicy(agentName string, policy *IamPolicy) (*IamPolicy, error) {
+	if policy == nil {
+		return nil, fmt.Errorf("IAM policy is required")
+	}
+
+	url, err := c.agentResourceURL(agentName)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"policy": policy,
+	}
+	body, err := c.doAgentsRequest(http.MethodPost, url+":setIamPolicy", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := &IamPolicy{}
+	if err := json.Unmarshal(body, updated); err != nil {
+		return nil, fmt.Errorf("failed to decode updated agent IAM policy: %w", err)
+	}
+	return updated, nil
+}
+
+// ShareAgent grants role to the given members, preserving existing bindings.
+func (c *GeminiClient) ShareAgent(agentName, role string, members []string) (*IamPolicy, error) {
+	return c.modifyAgentAccess(agentName, role, members, true)
+}
+
+// UnshareAgent revokes role from the given members, preserving other bindings.
+func (c *GeminiClient) UnshareAgent(agentName, role string, members []string) (*IamPolicy, error) {
+	return c.modifyAgentAccess(agentName, role, members, false)
+}
+
+// PublishAgent makes an agent available to users in the agent gallery.
+func (c *GeminiClient) PublishAgent(agentName string) (*Agent, error) {
+	return c.agentStateAction(agentName, "enable")
+}
+
+// UnpublishAgent removes an agent from the agent gallery without deleting it.
+func (c *GeminiClient) UnpublishAgent(agentName string) (*Agent, error) {
+	return c.agentStateAction(agentName, "disable")
+}
+
+// AgentAccessEntries flattens an IAM policy into sorted role/member pairs.
+func AgentAccessEntries(policy *IamPolicy) []AgentAccessEntry {
+	entries := []AgentAccessEntry{}
+	if policy == nil {
+		return entries
+	}
+	for _, binding := range policy.Bindings {
+		if binding == nil {
+			continue
+		}
+		for _, member := range binding.Members {
+			entries = append(entries, AgentAccessEntry{Role: binding.Role, Member: member})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Role != entries[j].Role {
+			return entries[i].Role < entries[j].Role
+		}
+		return entries[i].Member < entries[j].Member
+	})
+	return entries
+}
+
+func (c *GeminiClient) modifyAgentAccess(agentName, role string, members []string, grant bool) (*IamPolicy, error) {
+	if strings.TrimSpace(role) == "" {
+		return nil, fmt.Errorf("role is required")
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("at least one member is required")
+	}
+
+	policy, err := c.GetAgentIamPolicy(agentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent IAM policy: %w", err)
+	}
+
+	var binding *IamBinding
+	for _, existing := range policy.Bindings {
+		if existing != nil && existing.Role == role && existing.Condition == nil {
+			binding = existing
+			break
+		}
+	}
+
+	if grant {
+		if binding == nil {
+			binding = &IamBinding{Role: role}
+			policy.Bindings = append(policy.Bindings, binding)
+		}
+		for _, member := range members {
+			if !containsString(binding.Members, member) {
+				binding.Members = append(binding.Members, member)
+			}
+		}
+	} else {
+		if binding == nil {
+			return policy, nil
+		}
+		remaining := binding.Members[:0]
+		for _, member := range binding.Members {
+			if !containsString(members, member) {
+				remaining = append(remaining, member)
+			}
+		}
+		binding.Members = remaining
+		if len(binding.Members) == 0 {
+			bindings := policy.Bindings[:0]
+			for _, existing := range policy.Bindings {
+				if existing != binding {
+					bindings = append(bindings, existing)
+				}
+			}
+			policy.Bindings = bindings
+		}
+	}
+
+	return c.SetAgentIamPolicy(agentName, policy)
+}
+
+func (c *GeminiClient) agentStateAction(agentName, action string) (*Agent, error) {
+	url, err := c.agentResourceURL(agentName)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doAgentsRequest(http.MethodPost, fmt.Sprintf("%s:%s", url, action), map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) == 0 {
+		return c.GetAgent(agentName)
+	}
+
+	var agent Agent
+	if err := json.Unmarshal(body, &agent); err != nil {
+		return nil, fmt.Errorf("failed to decode agent response: %w", err)
+	}
+	if agent.Name == "" {
+		// Long-running operation response; return the current registration instead.
+		return c.GetAgent(agentName)
+	}
+	return &agent, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
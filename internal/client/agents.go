@@ -15,6 +15,15 @@ import (
 
 const defaultAssistantID = "default_assistant"
 
+const (
+	// AgentKindDialogflow identifies agents backed by a Dialogflow CX agent.
+	AgentKindDialogflow = "DIALOGFLOW"
+	// AgentKindADK identifies ADK agents deployed to Vertex AI Agent Engine.
+	AgentKindADK = "ADK"
+	// AgentKindUnknown identifies agents whose definition is not recognised by gemctl.
+	AgentKindUnknown = "UNKNOWN"
+)
+
 // Agent represents a Gemini Enterprise Dialogflow agent registration
 type Agent struct {
 	Name                      string                     `json:"name,omitempty"`
@@ -22,6 +31,7 @@ type Agent struct {
 	Description               string                     `json:"description,omitempty"`
 	Icon                      *AgentIcon                 `json:"icon,omitempty"`
 	DialogflowAgentDefinition *DialogflowAgentDefinition `json:"dialogflowAgentDefinition,omitempty"`
+	AdkAgentDefinition        *AdkAgentDefinition        `json:"adkAgentDefinition,omitempty"`
 	ReasoningEngine           string                     `json:"reasoningEngine,omitempty"`
 	CreateTime                string                     `json:"createTime,omitempty"`
 	UpdateTime                string                     `json:"updateTime,omitempty"`
@@ -31,6 +41,7 @@ type Agent struct {
 	Labels                    map[string]string          `json:"labels,omitempty"`
 	EnvironmentConfigurations []map[string]interface{}   `json:"environmentConfigurations,omitempty"`
 	AgentMonitoringState      map[string]interface{}     `json:"agentMonitoringState,omitempty"`
+	State                     string                     `json:"state,omitempty"`
 	Capabilities              []string                   `json:"capabilities,omitempty"`
 	Metadata                  map[string]interface{}     `json:"metadata,omitempty"`
 }
@@ -46,13 +57,36 @@ type DialogflowAgentDefinition struct {
 	DialogflowAgent string `json:"dialogflowAgent,omitempty"`
 }
 
+// AdkAgentDefinition represents an ADK agent deployed to Vertex AI Agent Engine
+type AdkAgentDefinition struct {
+	ToolSettings               *AdkToolSettings            `json:"toolSettings,omitempty"`
+	ProvisionedReasoningEngine *ProvisionedReasoningEngine `json:"provisionedReasoningEngine,omitempty"`
+	Authorizations             []string                    `json:"authorizations,omitempty"`
+}
+
+// AdkToolSettings describes how the assistant should invoke the ADK agent
+type AdkToolSettings struct {
+	ToolDescription string `json:"toolDescription,omitempty"`
+}
+
+// ProvisionedReasoningEngine references the Agent Engine resource hosting the ADK agent
+type ProvisionedReasoningEngine struct {
+	ReasoningEngine string `json:"reasoningEngine,omitempty"`
+}
+
 // AgentCreateInput represents the payload for creating an agent
 type AgentCreateInput struct {
 	DisplayName               string                     `json:"displayName,omitempty"`
 	Description               string                     `json:"description,omitempty"`
 	Icon                      *AgentIcon                 `json:"icon,omitempty"`
 	DialogflowAgentDefinition *DialogflowAgentDefinition `json:"dialogflowAgentDefinition,omitempty"`
+	AdkAgentDefinition        *AdkAgentDefinition        `json:"adkAgentDefinition,omitempty"`
 	ReasoningEngine           string                     `json:"reasoningEngine,omitempty"`
+	ConnectorDefinition       map[string]interface{}     `json:"connectorDefinition,omitempty"`
+	AdditionalAgentProperties map[string]interface{}     `json:"additionalAgentProperties,omitempty"`
+	Labels                    map[string]string          `json:"labels,omitempty"`
+	Annotations               map[string]string          `json:"annotations,omitempty"`
+	EnvironmentConfigurations []map[string]interface{}   `json:"environmentConfigurations,omitempty"`
 }
 
 // AgentUpdateInput represents the payload for updating an agent
@@ -61,12 +95,41 @@ type AgentUpdateInput struct {
 	Description               string                     `json:"description,omitempty"`
 	Icon                      *AgentIcon                 `json:"icon,omitempty"`
 	DialogflowAgentDefinition *DialogflowAgentDefinition `json:"dialogflowAgentDefinition,omitempty"`
+	AdkAgentDefinition        *AdkAgentDefinition        `json:"adkAgentDefinition,omitempty"`
 	ReasoningEngine           string                     `json:"reasoningEngine,omitempty"`
+	ConnectorDefinition       map[string]interface{}     `json:"connectorDefinition,omitempty"`
+	AdditionalAgentProperties map[string]interface{}     `json:"additionalAgentProperties,omitempty"`
+	Labels                    map[string]string          `json:"labels,omitempty"`
+	Annotations               map[string]string          `json:"annotations,omitempty"`
+	EnvironmentConfigurations []map[string]interface{}   `json:"environmentConfigurations,omitempty"`
+}
+
+// Kind reports which agent definition backs the registration
+func (a *Agent) Kind() string {
+	if a == nil {
+		return AgentKindUnknown
+	}
+	if a.AdkAgentDefinition != nil {
+		return AgentKindADK
+	}
+	if a.DialogflowAgentDefinition != nil {
+		return AgentKindDialogflow
+	}
+	return AgentKindUnknown
+}
+
+// AdkReasoningEngine returns the Agent Engine resource for ADK agents, if any
+func (a *Agent) AdkReasoningEngine() string {
+	if a == nil || a.AdkAgentDefinition == nil || a.AdkAgentDefinition.ProvisionedReasoningEngine == nil {
+		return ""
+	}
+	return a.AdkAgentDefinition.ProvisionedReasoningEngine.ReasoningEngine
 }
 
-// ListAgents retrieves all agents registered to a given engine assistant
-func (c *GeminiClient) ListAgents(engineName string) ([]*Agent, error) {
-	url, err := c.agentCollectionURL(engineName)
+// ListAgents retrieves all agents registered to an assistant. parent may be an
+// assistant name or an engine name, in which case the default assistant is used.
+func (c *GeminiClient) ListAgents(parent string) ([]*Agent, error) {
+	url, err := c.agentCollectionURL(parent)
 	if err != nil {
 		return nil, err
 	}
@@ -119,13 +182,13 @@ func (c *GeminiClient) GetAgent(agentName string) (*Agent, error) {
 	return &agent, nil
 }
 
-// CreateAgent registers a Dialogflow agent against the engine assistant
-func (c *GeminiClient) CreateAgent(engineName string, input *AgentCreateInput) (*Agent, error) {
+// CreateAgent registers a Dialogflow or ADK agent against an assistant
+func (c *GeminiClient) CreateAgent(parent string, input *AgentCreateInput) (*Agent, error) {
 	if input == nil {
 		return nil, fmt.Errorf("agent create payload is required")
 	}
 
-	url, err := c.agentCollectionURL(engineName)
+	url, err := c.agentCollectionURL(parent)
 	if err != nil {
 		return nil, err
 	}
@@ -194,22 +257,23 @@ func (c *GeminiClient) DeleteAgent(agentName string) (*DeleteResult, error) {
 	}, nil
 }
 
-// ConstructAgentName constructs the fully-qualified agent resource name
-func ConstructAgentName(engineName, agentID string) string {
+// ConstructAgentName constructs the fully-qualified agent resource name.
+// parent may be an assistant name or an engine name (default assistant).
+func ConstructAgentName(parent, agentID string) string {
 	if strings.Contains(agentID, "/") {
 		return agentID
 	}
-	return fmt.Sprintf("%s/assistants/%s/agents/%s", engineName, defaultAssistantID, agentID)
+	return fmt.Sprintf("%s/agents/%s", assistantParent(parent), agentID)
 }
 
-func (c *GeminiClient) agentCollectionURL(engineName string) (string, error) {
-	engineName = strings.TrimPrefix(engineName, "/")
-	if engineName == "" {
+func (c *GeminiClient) agentCollectionURL(parent string) (string, error) {
+	parent = strings.TrimPrefix(parent, "/")
+	if parent == "" {
 		return "", fmt.Errorf("engine name is required")
 	}
 
 	base := strings.TrimRight(c.service.BasePath, "/")
-	return fmt.Sprintf("%s/v1alpha/%s/assistants/%s/agents", base, engineName, defaultAssistantID), nil
+	return fmt.Sprintf("%s/v1alpha/%s/agents", base, assistantParent(parent)), nil
 }
 
 func (c *GeminiClient) agentResourceURL(agentName string) (string, error) {
@@ -223,11 +287,34 @@ func (c *GeminiClient) agentResourceURL(agentName string) (string, error) {
 }
 
 func (c *GeminiClient) doAgentsRequest(method, urlStr string, payload interface{}) ([]byte, error) {
+	return c.doAPIRequest("agents", method, urlStr, payload)
+}
+
+// doAPIRequest issues an authenticated JSON request against a Google REST API
+// that is not covered by the generated discoveryengine client.
+func (c *GeminiClient) doAPIRequest(apiName, method, urlStr string, payload interface{}) ([]byte, error) {
+	resp, err := c.sendAPIRequest(apiName, method, urlStr, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s API response: %w", apiName, err)
+	}
+
+	return data, nil
+}
+
+// sendAPIRequest issues the request and returns the open response for callers
+// that need to stream the body. Non-2xx responses are converted to errors.
+func (c *GeminiClient) sendAPIRequest(apiName, method, urlStr string, payload interface{}) (*http.Response, error) {
 	var body io.Reader
 	if payload != nil {
 		buf := &bytes.Buffer{}
 		if err := json.NewEncoder(buf).Encode(payload); err != nil {
-			return nil, fmt.Errorf("failed to encode agent payload: %w", err)
+			return nil, fmt.Errorf("failed to encode %s payload: %w", apiName, err)
 		}
 		body = buf
 	}
@@ -258,21 +345,30 @@ func (c *GeminiClient) doAgentsRequest(method, urlStr string, payload interface{
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("agents API request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read agents API response: %w", err)
+		return nil, fmt.Errorf("%s API request failed: %w", apiName, err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("agents API %s %s returned status %d: %s",
-			method, urlStr, resp.StatusCode, strings.TrimSpace(string(data)))
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return nil, &apiStatusError{
+			Code: resp.StatusCode,
+			message: fmt.Sprintf("%s API %s %s returned status %d: %s",
+				apiName, method, urlStr, resp.StatusCode, strings.TrimSpace(string(data))),
+		}
 	}
 
-	return data, nil
+	return resp, nil
+}
+
+// apiStatusError reports a non-2xx response returned to doAPIRequest.
+type apiStatusError struct {
+	Code    int
+	message string
+}
+
+func (e *apiStatusError) Error() string {
+	return e.message
 }
 
 func (c *GeminiClient) agentsUserAgent() string {
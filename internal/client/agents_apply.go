@@ -0,0 +1,111 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const defaultAgentApplyConcurrency = 4
+
+// AgentApplyResult reports the outcome of a single planned agent change.
+type AgentApplyResult struct {
+	Key         string        `json:"key"`
+	DisplayName string        `json:"displayName"`
+	ChangeType  AgentDiffKind `json:"changeType"`
+	Status      string        `json:"status"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// PlanAgents compares desired agent definitions with the agents registered on an
+// engine. Agents missing from desired are only scheduled for removal when prune is set.
+// Only fields a definition sets are compared, so apply never clears fields such as
+// connectorDefinition that an apply file cannot express.
+func (c *GeminiClient) PlanAgents(parent string, desired []*Agent, prune bool) ([]AgentDiff, error) {
+	seen := make(map[string]string, len(desired))
+	for _, agent := range desired {
+		key := agentSnapshotKey(agent)
+		if previous, ok := seen[key]; ok {
+			return nil, fmt.Errorf("agents %q and %q resolve to the same key %s", previous, agent.DisplayName, key)
+		}
+		seen[key] = agent.DisplayName
+	}
+
+	current, err := c.ListAgents(parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	changes := []AgentDiff{}
+	for _, change := range diffAgentList(current, desired, true) {
+		if change.ChangeType == AgentDiffRemoved && !prune {
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// ApplyAgentChanges executes planned agent changes concurrently and reports
+// the result of each one. Failures do not stop the remaining changes.
+func (c *GeminiClient) ApplyAgentChanges(parent string, changes []AgentDiff, concurrency int) []AgentApplyResult {
+	if concurrency <= 0 {
+		concurrency = defaultAgentApplyConcurrency
+	}
+
+	results := make([]AgentApplyResult, len(changes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, change := range changes {
+		wg.Add(1)
+		go func(i int, change AgentDiff) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := AgentApplyResult{
+				Key:         change.Key,
+				DisplayName: agentDiffDisplayName(change),
+				ChangeType:  change.ChangeType,
+				Status:      "success",
+			}
+			if err := c.applyAgentDiff(parent, change); err != nil {
+				result.Status = "error"
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, change)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// PlannedAgentResults converts planned changes into results without applying them.
+func PlannedAgentResults(changes []AgentDiff) []AgentApplyResult {
+	results := make([]AgentApplyResult, 0, len(changes))
+	for _, change := range changes {
+		results = append(results, AgentApplyResult{
+			Key:         change.Key,
+			DisplayName: agentDiffDisplayName(change),
+			ChangeType:  change.ChangeType,
+			Status:      "planned",
+		})
+	}
+	return results
+}
+
+func agentDiffDisplayName(change AgentDiff) string {
+	if change.New != nil && change.New.DisplayName != "" {
+		return change.New.DisplayName
+	}
+	if change.Old != nil {
+		return change.Old.DisplayName
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const assistStateFailed = "FAILED"
+
+// AssistResult captures the outcome of a single streamAssist query.
+type AssistResult struct {
+	Prompt            string   `json:"prompt"`
+	AgentID           string   `json:"agentId,omitempty"`
+	Response          string   `json:"response"`
+	State             string   `json:"state,omitempty"`
+	Session           string   `json:"session,omitempty"`
+	LatencyMs         int64    `json:"latencyMs"`
+	FirstChunkLatency int64    `json:"firstChunkLatencyMs,omitempty"`
+	Errors            []string `json:"errors,omitempty"`
+}
+
+// Failed reports whether the assistant returned an error or skipped the query.
+func (r *AssistResult) Failed() bool {
+	return r != nil && (r.State == assistStateFailed || len(r.Errors) > 0)
+}
+
+type streamAssistResponse struct {
+	Answer *struct {
+		State                string   `json:"state"`
+		AssistSkippedReasons []string `json:"assistSkippedReasons"`
+		Replies              []struct {
+			GroundedContent *struct {
+				Content *struct {
+					Text    string `json:"text"`
+					Thought bool   `json:"thought"`
+				} `json:"content"`
+			} `json:"groundedContent"`
+		} `json:"replies"`
+	} `json:"answer"`
+	SessionInfo *struct {
+		Session string `json:"session"`
+	} `json:"sessionInfo"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// StreamAssist sends a prompt to an assistant (or an engine's default assistant),
+// routing it to agentID when set. onText is called with each non-thought text
+// chunk as it arrives.
+func (c *GeminiClient) StreamAssist(parent, agentID, prompt string, onText func(string)) (*AssistResult, error) {
+	if strings.TrimSpace(prompt) == "" {
+		return nil, fmt.Errorf("prompt is required")
+	}
+
+	url, err := c.assistantMethodURL(parent, "streamAssist")
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"query": map[string]interface{}{
+			"text": prompt,
+		},
+		"session": fmt.Sprintf("%s/sessions/-", engineFromAssistantName(parent)),
+	}
+	if agentID != "" {
+		payload["agentsSpec"] = map[string]interface{}{
+			"agentSpecs": []map[string]interface{}{
+				{"agentId": extractResourceID(agentID)},
+			},
+		}
+	}
+
+	result := &AssistResult{
+		Prompt:  prompt,
+		AgentID: extractResourceID(agentID),
+	}
+
+	start := time.Now()
+	resp, err := c.sendAPIRequest("assistant", http.MethodPost, url, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var response strings.Builder
+	err = decodeJSONStream(resp.Body, func(raw json.RawMessage) error {
+		var chunk streamAssistResponse
+		if err := json.Unmarshal(raw, &chunk); err != nil {
+			return fmt.Errorf("failed to decode streamAssist chunk: %w", err)
+		}
+		if result.FirstChunkLatency == 0 {
+			result.FirstChunkLatency = time.Since(start).Milliseconds()
+		}
+
+		if chunk.Error != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%d: %s", chunk.Error.Code, chunk.Error.Message))
+		}
+		if chunk.SessionInfo != nil && chunk.SessionInfo.Session != "" {
+			result.Session = chunk.SessionInfo.Session
+		}
+		if chunk.Answer == nil {
+			return nil
+		}
+		if chunk.Answer.State != "" {
+			result.State = chunk.Answer.State
+		}
+		for _, reason := range chunk.Answer.AssistSkippedReasons {
+			result.Errors = append(result.Errors, "skipped: "+reason)
+		}
+		for _, reply := range chunk.Answer.Replies {
+			if reply.GroundedContent == nil || reply.GroundedContent.Content == nil {
+				continue
+			}
+			content := reply.GroundedContent.Content
+			if content.Thought || content.Text == "" {
+				continue
+			}
+			response.WriteString(content.Text)
+			if onText != nil {
+				onText(content.Text)
+			}
+		}
+		return nil
+	})
+	result.LatencyMs = time.Since(start).Milliseconds()
+	result.Response = response.String()
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (c *GeminiClient) assistantMethodURL(parent, method string) (string, error) {
+	parent = strings.TrimPrefix(parent, "/")
+	if parent == "" {
+		return "", fmt.Errorf("engine name is required")
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	return fmt.Sprintf("%s/v1alpha/%s:%s", base, assistantParent(parent), method), nil
+}
+
+// decodeJSONStream reads a streamed JSON array and invokes
+// fn for each element as soon as it has been received.
+func decodeJSONStream(r io.Reader, fn func(json.RawMessage) error) error {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read stream: %w", err)
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("unexpected stream token %v", token)
+	}
+
+	for decoder.More() {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return fmt.Errorf("failed to read stream element: %w", err)
+		}
+		if err := fn(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
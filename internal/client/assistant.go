@@ -0,0 +1,299 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Assistant represents the engine assistant that agents are registered against
+type Assistant struct {
+	Name                         string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	DisplayName                  string                     `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Description                  string                     `json:"description,omitempty" yaml:"description,omitempty"`
+	GenerationConfig             *AssistantGenerationConfig `json:"generationConfig,omitempty" yaml:"generationConfig,omitempty"`
+	WebGroundingType             string                     `json:"webGroundingType,omitempty" yaml:"webGroundingType,omitempty"`
+	DefaultWebGroundingToggleOff bool                       `json:"defaultWebGroundingToggleOff,omitempty" yaml:"defaultWebGroundingToggleOff,omitempty"`
+	EnabledTools                 map[string]interface{}     `json:"enabledTools,omitempty" yaml:"enabledTools,omitempty"`
+	CustomerPolicy               map[string]interface{}     `json:"customerPolicy,omitempty" yaml:"customerPolicy,omitempty"`
+}
+
+// AssistantGenerationConfig controls how the assistant generates answers
+type AssistantGenerationConfig struct {
+	SystemInstruction *AssistantSystemInstruction `json:"systemInstruction,omitempty" yaml:"systemInstruction,omitempty"`
+	DefaultLanguage   string                      `json:"defaultLanguage,omitempty" yaml:"defaultLanguage,omitempty"`
+}
+
+// AssistantSystemInstruction holds instructions appended to the assistant's system prompt
+type AssistantSystemInstruction struct {
+	AdditionalSystemInstruction string `json:"additionalSystemInstruction,omitempty" yaml:"additionalSystemInstruction,omitempty"`
+}
+
+// AssistantUpdatableFields lists the assistant fields gemctl can update
+var AssistantUpdatableFields = []string{
+	"displayName",
+	"description",
+	"generationConfig",
+	"webGroundingType",
+	"defaultWebGroundingToggleOff",
+	"enabledTools",
+	"customerPolicy",
+}
+
+// SystemInstruction returns the additional system instruction, if any
+func (a *Assistant) SystemInstruction() string {
+	if a == nil || a.GenerationConfig == nil || a.GenerationConfig.SystemInstruction == nil {
+		return ""
+	}
+	return a.GenerationConfig.SystemInstruction.AdditionalSystemInstruction
+}
+
+// DefaultLanguage returns the assistant's default answer language, if any
+func (a *Assistant) DefaultLanguage() string {
+	if a == nil || a.GenerationConfig == nil {
+		return ""
+	}
+	return a.GenerationConfig.DefaultLanguage
+}
+
+// GetAssistant retrieves the assistant configuration
+func (c *GeminiClient) GetAssistant(assistantName string) (*Assistant, error) {
+	url, err := c.assistantResourceURL(assistantName)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doAPIRequest("assistant", http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assistant Assistant
+	if err := json.Unmarshal(body, &assistant); err != nil {
+		return nil, fmt.Errorf("failed to decode assistant response: %w", err)
+	}
+
+	return &assistant, nil
+}
+
+// UpdateAssistant patches the assistant using the provided update mask
+func (c *GeminiClient) UpdateAssistant(assistantName string, assistant *Assistant, updateMask []string) (*Assistant, error) {
+	if assistant == nil {
+		return nil, fmt.Errorf("assistant update payload is required")
+	}
+
+	url, err := c.assistantResourceURL(assistantName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(updateMask) > 0 {
+		url = fmt.Sprintf("%s?%s", url, urlValuesFromMask(updateMask).Encode())
+	}
+
+	payload := *assistant
+	payload.Name = ""
+
+	body, err := c.doAPIRequest("assistant", http.MethodPatch, url, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Assistant
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode updated assistant: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// ListAssistants retrieves all assistants defined on an engine
+func (c *GeminiClient) ListAssistants(engineName string) ([]*Assistant, error) {
+	engineName = strings.TrimPrefix(engineName, "/")
+	if engineName == "" {
+		return nil, fmt.Errorf("engine name is required")
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	collectionURL := fmt.Sprintf("%s/v1alpha/%s/assistants", base, engineName)
+
+	assistants := []*Assistant{}
+	pageToken := ""
+	for {
+		pageURL := collectionURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("assistant", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Assistants    []*Assistant `json:"assistants"`
+			NextPageToken string       `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode assistants response: %w", err)
+		}
+		assistants = append(assistants, response.Assistants...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	return assistants, nil
+}
+
+// CreateAssistant creates an additional assistant on an engine
+func (c *GeminiClient) CreateAssistant(engineName, assistantID string, assistant *Assistant) (*Assistant, error) {
+	if assistantID == "" {
+		return nil, fmt.Errorf("assistant ID is required")
+	}
+	if assistant == nil {
+		assistant = &Assistant{}
+	}
+
+	engineName = strings.TrimPrefix(engineName, "/")
+	if engineName == "" {
+		return nil, fmt.Errorf("engine name is required")
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	createURL := fmt.Sprintf("%s/v1alpha/%s/assistants?assistantId=%s", base, engineName, url.QueryEscape(assistantID))
+
+	payload := *assistant
+	payload.Name = ""
+
+	body, err := c.doAPIRequest("assistant", http.MethodPost, createURL, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Assistant
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode created assistant: %w", err)
+	}
+
+	return &created, nil
+}
+
+// DeleteAssistant removes an assistant and the agents registered to it
+func (c *GeminiClient) DeleteAssistant(assistantName string) (*DeleteResult, error) {
+	if extractResourceID(assistantName) == defaultAssistantID {
+		return nil, fmt.Errorf("the default assistant cannot be deleted")
+	}
+
+	resourceURL, err := c.assistantResourceURL(assistantName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.doAPIRequest("assistant", http.MethodDelete, resourceURL, nil); err != nil {
+		return nil, err
+	}
+
+	return &DeleteResult{
+		Status:  "success",
+		Message: "Assistant deleted successfully",
+	}, nil
+}
+
+// IsDefaultAssistant reports whether the assistant is the engine's default assistant
+func (a *Assistant) IsDefaultAssistant() bool {
+	return a != nil && extractResourceID(a.Name) == defaultAssistantID
+}
+
+// ConstructAssistantName constructs the fully-qualified assistant resource name
+func ConstructAssistantName(engineName, assistantID string) string {
+	if assistantID == "" {
+		assistantID = defaultAssistantID
+	}
+	if strings.Contains(assistantID, "/") {
+		return assistantID
+	}
+	return fmt.Sprintf("%s/assistants/%s", strings.TrimPrefix(engineName, "/"), assistantID)
+}
+
+// DiffAssistantFields returns the update mask needed to turn current into desired
+func DiffAssistantFields(current, desired *Assistant) []string {
+	if desired == nil {
+		return nil
+	}
+	if current == nil {
+		current = &Assistant{}
+	}
+
+	mask := []string{}
+	if current.DisplayName != desired.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if current.Description != desired.Description {
+		mask = append(mask, "description")
+	}
+	if !jsonValuesEqual(current.GenerationConfig, desired.GenerationConfig) {
+		mask = append(mask, "generationConfig")
+	}
+	if current.WebGroundingType != desired.WebGroundingType {
+		mask = append(mask, "webGroundingType")
+	}
+	if current.DefaultWebGroundingToggleOff != desired.DefaultWebGroundingToggleOff {
+		mask = append(mask, "defaultWebGroundingToggleOff")
+	}
+	if !jsonValuesEqual(current.EnabledTools, desired.EnabledTools) {
+		mask = append(mask, "enabledTools")
+	}
+	if !jsonValuesEqual(current.CustomerPolicy, desired.CustomerPolicy) {
+		mask = append(mask, "customerPolicy")
+	}
+	return mask
+}
+
+// assistantParent resolves an engine or assistant name to an assistant name,
+// using the default assistant for bare engine names.
+func assistantParent(name string) string {
+	name = strings.TrimPrefix(name, "/")
+	if strings.Contains(name, "/assistants/") {
+		return name
+	}
+	return ConstructAssistantName(name, defaultAssistantID)
+}
+
+// engineFromAssistantName returns the engine portion of an assistant or engine name.
+func engineFromAssistantName(name string) string {
+	name = strings.TrimPrefix(name, "/")
+	if idx := strings.Index(name, "/assistants/"); idx >= 0 {
+		return name[:idx]
+	}
+	return name
+}
+
+func (c *GeminiClient) assistantResourceURL(assistantName string) (string, error) {
+	assistantName = strings.TrimPrefix(assistantName, "/")
+	if assistantName == "" {
+		return "", fmt.Errorf("assistant name is required")
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	return fmt.Sprintf("%s/v1alpha/%s", base, assistantName), nil
+}
+
+func cloneAssistant(src *Assistant) *Assistant {
+	if src == nil {
+		return nil
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil
+	}
+	var dst Assistant
+	if err := json.Unmarshal(data, &dst); err != nil {
+		return nil
+	}
+	return &dst
+}
@@ -54,16 +54,16 @@ type SearchEngineConfig struct {
 
 // DataStore represents a Gemini Enterprise data store
 type DataStore struct {
-	Name                     string                 `json:"name"`
-	DisplayName              string                 `json:"displayName"`
-	IndustryVertical         string                 `json:"industryVertical"`
-	ContentConfig            string                 `json:"contentConfig"`
-	CreateTime               string                 `json:"createTime"`
-	SolutionTypes            []string               `json:"solutionTypes,omitempty"`
-	AclEnabled               bool                   `json:"aclEnabled,omitempty"`
-	BillingEstimation        *BillingEstimation     `json:"billingEstimation,omitempty"`
-	DocumentProcessingConfig map[string]interface{} `json:"documentProcessingConfig,omitempty"`
-	Schema                   map[string]interface{} `json:"schema,omitempty"`
+	Name                     string                    `json:"name"`
+	DisplayName              string                    `json:"displayName"`
+	IndustryVertical         string                    `json:"industryVertical"`
+	ContentConfig            string                    `json:"contentConfig"`
+	CreateTime               string                    `json:"createTime"`
+	SolutionTypes            []string                  `json:"solutionTypes,omitempty"`
+	AclEnabled               bool                      `json:"aclEnabled,omitempty"`
+	BillingEstimation        *BillingEstimation        `json:"billingEstimation,omitempty"`
+	DocumentProcessingConfig *DocumentProcessingConfig `json:"documentProcessingConfig,omitempty"`
+	Schema                   map[string]interface{}    `json:"schema,omitempty"`
 }
 
 // BillingEstimation represents billing information
@@ -0,0 +1,507 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	secretVersionPattern   = regexp.MustCompile(`^projects/[^/]+/secrets/[^/]+/versions/[^/]+$`)
+	connectorSecretPattern = regexp.MustCompile(`(?i)(secret|password|token|private_key|api_key|apikey|credential)`)
+)
+
+// DataConnector is the connector that syncs a third-party source (Jira,
+// Confluence, SharePoint, ...) into the data stores of a collection.
+type DataConnector struct {
+	Name                       string                 `json:"name,omitempty"`
+	DataSource                 string                 `json:"dataSource"`
+	State                      string                 `json:"state,omitempty"`
+	SyncMode                   string                 `json:"syncMode,omitempty"`
+	RefreshInterval            string                 `json:"refreshInterval,omitempty"`
+	IncrementalRefreshInterval string                 `json:"incrementalRefreshInterval,omitempty"`
+	AutoRunDisabled            bool                   `json:"autoRunDisabled,omitempty"`
+	AclEnabled                 bool                   `json:"aclEnabled,omitempty"`
+	Params                     map[string]interface{} `json:"params,omitempty"`
+	Entities                   []*DataConnectorEntity `json:"entities,omitempty"`
+	LastSyncTime               string                 `json:"lastSyncTime,omitempty"`
+	LatestPauseTime            string                 `json:"latestPauseTime,omitempty"`
+	CreateTime                 string                 `json:"createTime,omitempty"`
+	UpdateTime                 string                 `json:"updateTime,omitempty"`
+	BlockingReasons            []string               `json:"blockingReasons,omitempty"`
+	Errors                     []*ConnectorError      `json:"errors,omitempty"`
+}
+
+// DataConnectorEntity is a source entity (e.g. Jira issues) synced into a data store.
+type DataConnectorEntity struct {
+	EntityName string                 `json:"entityName"`
+	DataStore  string                 `json:"dataStore,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+}
+
+// ConnectorError is an error reported by a connector or connector run.
+type ConnectorError struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// ConnectorRun is one sync run of a data connector.
+type ConnectorRun struct {
+	Name            string                `json:"name"`
+	State           string                `json:"state"`
+	Trigger         string                `json:"trigger,omitempty"`
+	StartTime       string                `json:"startTime,omitempty"`
+	EndTime         string                `json:"endTime,omitempty"`
+	StateUpdateTime string                `json:"stateUpdateTime,omitempty"`
+	EntityRuns      []*ConnectorEntityRun `json:"entityRuns,omitempty"`
+	Errors          []*ConnectorError     `json:"errors,omitempty"`
+}
+
+// ConnectorEntityRun reports the sync results for a single entity.
+type ConnectorEntityRun struct {
+	EntityName           string            `json:"entityName"`
+	State                string            `json:"state"`
+	SyncType             string            `json:"syncType,omitempty"`
+	ExtractedRecordCount int64             `json:"extractedRecordCount,omitempty,string"`
+	IndexedRecordCount   int64             `json:"indexedRecordCount,omitempty,string"`
+	ErrorRecordCount     int64             `json:"errorRecordCount,omitempty,string"`
+	DeletedRecordCount   int64             `json:"deletedRecordCount,omitempty,string"`
+	Errors               []*ConnectorError `json:"errors,omitempty"`
+}
+
+// ConnectorSpec describes a connector to create or update. Credentials are
+// given as Secret Manager version references in SecretParams. The references
+// are sent as param values and resolved by the service, so secret values never
+// pass through gemctl or appear in spec files or snapshots.
+type ConnectorSpec struct {
+	ID                         string                 `json:"id" yaml:"id"`
+	DisplayName                string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	DataSource                 string                 `json:"dataSource" yaml:"dataSource"`
+	RefreshInterval            string                 `json:"refreshInterval,omitempty" yaml:"refreshInterval,omitempty"`
+	IncrementalRefreshInterval string                 `json:"incrementalRefreshInterval,omitempty" yaml:"incrementalRefreshInterval,omitempty"`
+	SyncMode                   string                 `json:"syncMode,omitempty" yaml:"syncMode,omitempty"`
+	AclEnabled                 bool                   `json:"aclEnabled,omitempty" yaml:"aclEnabled,omitempty"`
+	Params                     map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
+	SecretParams               map[string]string      `json:"secretParams,omitempty" yaml:"secretParams,omitempty"`
+	Entities                   []ConnectorEntitySpec  `json:"entities,omitempty" yaml:"entities,omitempty"`
+}
+
+// ConnectorEntitySpec describes a source entity to sync.
+type ConnectorEntitySpec struct {
+	EntityName string                 `json:"entityName" yaml:"entityName"`
+	Params     map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
+}
+
+// LoadConnectorSpec reads a connector spec from a YAML (or JSON) file.
+func LoadConnectorSpec(path string) (*ConnectorSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read connector spec %s: %w", path, err)
+	}
+
+	var spec ConnectorSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse connector spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// Validate checks required fields and rejects credentials given in plain text.
+func (s *ConnectorSpec) Validate() error {
+	if strings.TrimSpace(s.ID) == "" {
+		return fmt.Errorf("connector ID is required")
+	}
+	if strings.TrimSpace(s.DataSource) == "" {
+		return fmt.Errorf("connector data source is required (e.g. jira, confluence, sharepoint)")
+	}
+	if err := validateConnectorParams(s.Params, s.SecretParams); err != nil {
+		return err
+	}
+	for i, entity := range s.Entities {
+		if strings.TrimSpace(entity.EntityName) == "" {
+			return fmt.Errorf("entity %d: entityName is required", i+1)
+		}
+	}
+	return nil
+}
+
+// validateConnectorParams rejects plain params that look like credentials and
+// secret params that are not Secret Manager version references.
+func validateConnectorParams(params map[string]interface{}, secretParams map[string]string) error {
+	for key := range params {
+		if connectorSecretPattern.MatchString(key) {
+			return fmt.Errorf("param %q looks like a credential; pass it under secretParams as a Secret Manager reference", key)
+		}
+	}
+	for key, ref := range secretParams {
+		if !secretVersionPattern.MatchString(ref) {
+			return fmt.Errorf("secret param %q must reference a secret version (projects/PROJECT/secrets/SECRET/versions/VERSION)", key)
+		}
+	}
+	return nil
+}
+
+// ListDataConnectors returns the data connectors of all collections in the
+// current project/location. Collections without a connector are skipped.
+func (c *GeminiClient) ListDataConnectors() ([]*DataConnector, error) {
+	base := strings.TrimRight(c.service.BasePath, "/")
+	collectionsURL := fmt.Sprintf("%s/v1alpha/projects/%s/locations/%s/collections", base, c.config.ProjectID, c.config.Location)
+
+	connectors := []*DataConnector{}
+	pageToken := ""
+	for {
+		pageURL := collectionsURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionsURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("collections", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list collections: %w", err)
+		}
+
+		var response struct {
+			Collections []struct {
+				Name string `json:"name"`
+			} `json:"collections"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode collections: %w", err)
+		}
+
+		for _, collection := range response.Collections {
+			connector, err := c.GetDataConnector(collection.Name)
+			if err != nil {
+				if isNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			connectors = append(connectors, connector)
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Slice(connectors, func(i, j int) bool {
+		return connectors[i].Name < connectors[j].Name
+	})
+	return connectors, nil
+}
+
+// GetDataConnector retrieves the data connector of a collection.
+func (c *GeminiClient) GetDataConnector(collectionName string) (*DataConnector, error) {
+	body, err := c.doAPIRequest("data connector", http.MethodGet, c.dataConnectorURL(collectionName, ""), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var connector DataConnector
+	if err := json.Unmarshal(body, &connector); err != nil {
+		return nil, fmt.Errorf("failed to decode data connector: %w", err)
+	}
+	return &connector, nil
+}
+
+// CreateDataConnector sets up a new collection with a data connector and
+// waits for the setup operation to finish.
+func (c *GeminiClient) CreateDataConnector(spec *ConnectorSpec) (*DataConnector, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	connector := connectorFromSpec(spec)
+
+	displayName := spec.DisplayName
+	if displayName == "" {
+		displayName = spec.ID
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	setupURL := fmt.Sprintf("%s/v1alpha/projects/%s/locations/%s:setUpDataConnector", base, c.config.ProjectID, c.config.Location)
+	payload := map[string]interface{}{
+		"collectionId":          spec.ID,
+		"collectionDisplayName": displayName,
+		"dataConnector":         connector,
+	}
+
+	body, err := c.doAPIRequest("data connector", http.MethodPost, setupURL, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up data connector: %w", err)
+	}
+
+	var operation struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return nil, fmt.Errorf("failed to decode setup operation: %w", err)
+	}
+	if operation.Name != "" {
+		if _, err := c.WaitForOperation(operation.Name, 0, 0, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.GetDataConnector(fmt.Sprintf("projects/%s/locations/%s/collections/%s", c.config.ProjectID, c.config.Location, spec.ID))
+}
+
+// UpdateDataConnector updates a connector from spec. Only the fields set in
+// the spec (schedule, params, secret params, entities) are changed.
+func (c *GeminiClient) UpdateDataConnector(collectionName string, spec *ConnectorSpec) (*DataConnector, error) {
+	if err := validateConnectorParams(spec.Params, spec.SecretParams); err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetDataConnector(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := []string{}
+	payload := map[string]interface{}{}
+	if spec.RefreshInterval != "" {
+		payload["refreshInterval"] = spec.RefreshInterval
+		mask = append(mask, "refreshInterval")
+	}
+	if spec.IncrementalRefreshInterval != "" {
+		payload["incrementalRefreshInterval"] = spec.IncrementalRefreshInterval
+		mask = append(mask, "incrementalRefreshInterval")
+	}
+	if len(spec.Params) > 0 || len(spec.SecretParams) > 0 {
+		// params is replaced as a whole, so credentials already on the
+		// connector must be passed again rather than echoed back.
+		params := cloneStringInterfaceMap(current.Params)
+		if params == nil {
+			params = map[string]interface{}{}
+		}
+		for key := range params {
+			if _, ok := spec.SecretParams[key]; !ok && connectorSecretPattern.MatchString(key) {
+				return nil, fmt.Errorf("connector param %q holds a credential; pass it again as a secret param when updating params", key)
+			}
+		}
+		for key, value := range spec.Params {
+			params[key] = value
+		}
+		addSecretParams(params, spec.SecretParams)
+		payload["params"] = params
+		mask = append(mask, "params")
+	}
+	if len(spec.Entities) > 0 {
+		payload["entities"] = connectorEntitiesFromSpec(spec.Entities)
+		mask = append(mask, "entities")
+	}
+	if len(mask) == 0 {
+		return nil, fmt.Errorf("no connector changes requested")
+	}
+
+	return c.patchDataConnector(collectionName, payload, mask)
+}
+
+// SetDataConnectorPaused pauses or resumes scheduled syncs of a connector.
+func (c *GeminiClient) SetDataConnectorPaused(collectionName string, paused bool) (*DataConnector, error) {
+	return c.patchDataConnector(collectionName, map[string]interface{}{"autoRunDisabled": paused}, []string{"autoRunDisabled"})
+}
+
+// DeleteDataConnector deletes the connector's collection, including the data
+// stores it syncs into, and waits for the deletion to finish.
+func (c *GeminiClient) DeleteDataConnector(collectionName string) error {
+	base := strings.TrimRight(c.service.BasePath, "/")
+	body, err := c.doAPIRequest("collection", http.MethodDelete, fmt.Sprintf("%s/v1alpha/%s", base, collectionName), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete connector collection: %w", err)
+	}
+
+	var operation struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &operation); err == nil && operation.Name != "" {
+		if _, err := c.WaitForOperation(operation.Name, 0, 0, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListConnectorRuns returns the sync run history of a connector, newest first.
+func (c *GeminiClient) ListConnectorRuns(collectionName string) ([]*ConnectorRun, error) {
+	runsURL := c.dataConnectorURL(collectionName, "/connectorRuns")
+
+	runs := []*ConnectorRun{}
+	pageToken := ""
+	for {
+		pageURL := runsURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", runsURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("connector runs", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			ConnectorRuns []*ConnectorRun `json:"connectorRuns"`
+			NextPageToken string          `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode connector runs: %w", err)
+		}
+		runs = append(runs, response.ConnectorRuns...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartTime > runs[j].StartTime
+	})
+	return runs, nil
+}
+
+// StartConnectorRun triggers a manual sync of the given entities (all when empty).
+func (c *GeminiClient) StartConnectorRun(collectionName string, entities []string, forceRefresh bool) (*ConnectorRun, error) {
+	payload := map[string]interface{}{}
+	if len(entities) > 0 {
+		payload["entities"] = entities
+	}
+	if forceRefresh {
+		payload["forceRefreshContent"] = true
+	}
+
+	body, err := c.doAPIRequest("connector run", http.MethodPost, c.dataConnectorURL(collectionName, ":startConnectorRun"), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start connector run: %w", err)
+	}
+
+	var run ConnectorRun
+	if err := json.Unmarshal(body, &run); err != nil {
+		return nil, fmt.Errorf("failed to decode connector run: %w", err)
+	}
+	return &run, nil
+}
+
+// Redacted returns a copy of the connector with credential-like params masked.
+func (d *DataConnector) Redacted() *DataConnector {
+	clone := *d
+	if len(d.Params) > 0 {
+		clone.Params = map[string]interface{}{}
+		for key, value := range d.Params {
+			if connectorSecretPattern.MatchString(key) {
+				value = defaultRedactionReplacement
+			}
+			clone.Params[key] = value
+		}
+	}
+	return &clone
+}
+
+// CollectionName returns the name of the collection the connector belongs to.
+func (d *DataConnector) CollectionName() string {
+	return strings.TrimSuffix(d.Name, "/dataConnector")
+}
+
+// ConnectorDataStoreIDs returns the IDs of the data stores the connector syncs into.
+func (d *DataConnector) ConnectorDataStoreIDs() []string {
+	ids := []string{}
+	for _, entity := range d.Entities {
+		if entity.DataStore != "" {
+			ids = append(ids, extractResourceID(entity.DataStore))
+		}
+	}
+	return ids
+}
+
+func (c *GeminiClient) patchDataConnector(collectionName string, payload map[string]interface{}, mask []string) (*DataConnector, error) {
+	patchURL := fmt.Sprintf("%s?%s", c.dataConnectorURL(collectionName, ""), urlValuesFromMask(mask).Encode())
+	body, err := c.doAPIRequest("data connector", http.MethodPatch, patchURL, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update data connector: %w", err)
+	}
+
+	var connector DataConnector
+	if err := json.Unmarshal(body, &connector); err != nil {
+		return nil, fmt.Errorf("failed to decode data connector: %w", err)
+	}
+	return &connector, nil
+}
+
+func connectorFromSpec(spec *ConnectorSpec) *DataConnector {
+	params := cloneStringInterfaceMap(spec.Params)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	addSecretParams(params, spec.SecretParams)
+
+	return &DataConnector{
+		DataSource:                 spec.DataSource,
+		RefreshInterval:            spec.RefreshInterval,
+		IncrementalRefreshInterval: spec.IncrementalRefreshInterval,
+		SyncMode:                   spec.SyncMode,
+		AclEnabled:                 spec.AclEnabled,
+		Params:                     params,
+		Entities:                   connectorEntitiesFromSpec(spec.Entities),
+	}
+}
+
+// addSecretParams stores each Secret Manager version reference in params under
+// its key. The connector service reads the secret, so its value never passes
+// through gemctl.
+func addSecretParams(params map[string]interface{}, secretParams map[string]string) {
+	for key, ref := range secretParams {
+		params[key] = ref
+	}
+}
+
+func (c *GeminiClient) dataConnectorURL(collectionName, suffix string) string {
+	base := strings.TrimRight(c.service.BasePath, "/")
+	return fmt.Sprintf("%s/v1alpha/%s/dataConnector%s", base, strings.TrimPrefix(collectionName, "/"), suffix)
+}
+
+func connectorEntitiesFromSpec(specs []ConnectorEntitySpec) []*DataConnectorEntity {
+	entities := make([]*DataConnectorEntity, 0, len(specs))
+	for _, spec := range specs {
+		entities = append(entities, &DataConnectorEntity{
+			EntityName: spec.EntityName,
+			Params:     cloneStringInterfaceMap(spec.Params),
+		})
+	}
+	return entities
+}
+
+// sanitizeConnector drops credential-like params so connector definitions can
+// be stored in snapshots.
+func sanitizeConnector(connector *DataConnector) *DataConnector {
+	if connector == nil {
+		return nil
+	}
+	clone := *connector
+	clone.Params = map[string]interface{}{}
+	for key, value := range connector.Params {
+		if !connectorSecretPattern.MatchString(key) {
+			clone.Params[key] = value
+		}
+	}
+	if len(clone.Params) == 0 {
+		clone.Params = nil
+	}
+	clone.State = ""
+	clone.LastSyncTime = ""
+	clone.LatestPauseTime = ""
+	clone.UpdateTime = ""
+	clone.Errors = nil
+	clone.BlockingReasons = nil
+	return &clone
+}
@@ -0,0 +1,568 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Control action types
+const (
+	ControlTypeBoost    = "boost"
+	ControlTypeFilter   = "filter"
+	ControlTypeRedirect = "redirect"
+	ControlTypeSynonyms = "synonyms"
+	ControlTypePromote  = "promote"
+)
+
+// Control is a serving control (boost, filter, redirect, synonyms or promote)
+// defined on an engine. A control only takes effect once it is linked to a
+// serving config.
+type Control struct {
+	Name                       string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	ID                         string                 `json:"-" yaml:"id,omitempty"`
+	DisplayName                string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	SolutionType               string                 `json:"solutionType,omitempty" yaml:"solutionType,omitempty"`
+	UseCases                   []string               `json:"useCases,omitempty" yaml:"useCases,omitempty"`
+	Conditions                 []*ControlCondition    `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	BoostAction                *ControlBoostAction    `json:"boostAction,omitempty" yaml:"boostAction,omitempty"`
+	FilterAction               *ControlFilterAction   `json:"filterAction,omitempty" yaml:"filterAction,omitempty"`
+	RedirectAction             *ControlRedirectAction `json:"redirectAction,omitempty" yaml:"redirectAction,omitempty"`
+	SynonymsAction             *ControlSynonymsAction `json:"synonymsAction,omitempty" yaml:"synonymsAction,omitempty"`
+	PromoteAction              *ControlPromoteAction  `json:"promoteAction,omitempty" yaml:"promoteAction,omitempty"`
+	AssociatedServingConfigIds []string               `json:"associatedServingConfigIds,omitempty" yaml:"associatedServingConfigIds,omitempty"`
+}
+
+// ControlCondition limits when a control applies. All query terms, the query
+// regex and the active time ranges of a condition must match.
+type ControlCondition struct {
+	QueryTerms      []*ControlQueryTerm `json:"queryTerms,omitempty" yaml:"queryTerms,omitempty"`
+	QueryRegex      string              `json:"queryRegex,omitempty" yaml:"queryRegex,omitempty"`
+	ActiveTimeRange []*ControlTimeRange `json:"activeTimeRange,omitempty" yaml:"activeTimeRange,omitempty"`
+}
+
+// ControlQueryTerm matches a query term, either as a substring or the full query.
+type ControlQueryTerm struct {
+	Value     string `json:"value" yaml:"value"`
+	FullMatch bool   `json:"fullMatch,omitempty" yaml:"fullMatch,omitempty"`
+}
+
+// ControlTimeRange is an RFC 3339 time window in which a control is active.
+type ControlTimeRange struct {
+	StartTime string `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty" yaml:"endTime,omitempty"`
+}
+
+// ControlBoostAction boosts (or buries, with a negative value) matching documents.
+type ControlBoostAction struct {
+	FixedBoost             float64                `json:"fixedBoost,omitempty" yaml:"fixedBoost,omitempty"`
+	Boost                  float64                `json:"boost,omitempty" yaml:"boost,omitempty"`
+	InterpolationBoostSpec map[string]interface{} `json:"interpolationBoostSpec,omitempty" yaml:"interpolationBoostSpec,omitempty"`
+	Filter                 string                 `json:"filter" yaml:"filter"`
+	DataStore              string                 `json:"dataStore" yaml:"dataStore"`
+}
+
+// ControlFilterAction restricts results to documents matching a filter.
+type ControlFilterAction struct {
+	Filter    string `json:"filter" yaml:"filter"`
+	DataStore string `json:"dataStore" yaml:"dataStore"`
+}
+
+// ControlRedirectAction sends matching queries to a URI.
+type ControlRedirectAction struct {
+	RedirectURI string `json:"redirectUri" yaml:"redirectUri"`
+}
+
+// ControlSynonymsAction treats a set of terms as synonyms.
+type ControlSynonymsAction struct {
+	Synonyms []string `json:"synonyms" yaml:"synonyms"`
+}
+
+// ControlPromoteAction pins a link at the top of matching results.
+type ControlPromoteAction struct {
+	DataStore           string                 `json:"dataStore" yaml:"dataStore"`
+	SearchLinkPromotion map[string]interface{} `json:"searchLinkPromotion" yaml:"searchLinkPromotion"`
+}
+
+// ControlsFile is the YAML document used to import and export controls.
+type ControlsFile struct {
+	Controls []*Control `json:"controls" yaml:"controls"`
+}
+
+// ControlUpdatableFields lists the control fields gemctl can update
+var ControlUpdatableFields = []string{
+	"displayName",
+	"useCases",
+	"conditions",
+	"boostAction",
+	"filterAction",
+	"redirectAction",
+	"synonymsAction",
+	"promoteAction",
+}
+
+// ControlID returns the control ID, from the explicit id field or the resource name.
+func (c *Control) ControlID() string {
+	if c == nil {
+		return ""
+	}
+	if c.ID != "" {
+		return c.ID
+	}
+	return extractResourceID(c.Name)
+}
+
+// ActionType returns the control's action type, or an empty string when no action is set.
+func (c *Control) ActionType() string {
+	switch {
+	case c == nil:
+		return ""
+	case c.BoostAction != nil:
+		return ControlTypeBoost
+	case c.FilterAction != nil:
+		return ControlTypeFilter
+	case c.RedirectAction != nil:
+		return ControlTypeRedirect
+	case c.SynonymsAction != nil:
+		return ControlTypeSynonyms
+	case c.PromoteAction != nil:
+		return ControlTypePromote
+	default:
+		return ""
+	}
+}
+
+// Validate checks that the control has an ID and exactly one action.
+func (c *Control) Validate() error {
+	if c.ControlID() == "" {
+		return fmt.Errorf("control ID is required")
+	}
+	actions := 0
+	for _, set := range []bool{c.BoostAction != nil, c.FilterAction != nil, c.RedirectAction != nil, c.SynonymsAction != nil, c.PromoteAction != nil} {
+		if set {
+			actions++
+		}
+	}
+	if actions != 1 {
+		return fmt.Errorf("control %s must define exactly one of boostAction, filterAction, redirectAction, synonymsAction, promoteAction", c.ControlID())
+	}
+	if c.SynonymsAction != nil && len(c.SynonymsAction.Synonyms) < 2 {
+		return fmt.Errorf("control %s: synonymsAction needs at least two synonyms", c.ControlID())
+	}
+	return nil
+}
+
+// ExportForm returns a copy of the control suitable for a controls file: the
+// ID is set and output-only fields are cleared.
+func (c *Control) ExportForm() *Control {
+	clone := cloneControl(c)
+	clone.ID = c.ControlID()
+	clone.Name = ""
+	clone.AssociatedServingConfigIds = nil
+	return clone
+}
+
+// LoadControlsFile reads controls from a YAML file with a top-level controls
+// list. A file holding a single control is accepted as well and may omit the
+// ID. Callers validate the controls once IDs are known.
+func LoadControlsFile(path string) ([]*Control, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read controls file %s: %w", path, err)
+	}
+
+	var file ControlsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse controls file: %w", err)
+	}
+	if len(file.Controls) == 0 {
+		var single Control
+		if err := yaml.Unmarshal(data, &single); err != nil {
+			return nil, fmt.Errorf("failed to parse controls file: %w", err)
+		}
+		if single.ControlID() == "" && single.ActionType() == "" {
+			return nil, fmt.Errorf("controls file %s defines no controls", path)
+		}
+		file.Controls = []*Control{&single}
+	}
+
+	seen := map[string]bool{}
+	for _, control := range file.Controls {
+		if control.ControlID() == "" {
+			continue
+		}
+		if seen[control.ControlID()] {
+			return nil, fmt.Errorf("control %s is defined more than once", control.ControlID())
+		}
+		seen[control.ControlID()] = true
+	}
+	return file.Controls, nil
+}
+
+// ListControls retrieves all controls defined on an engine
+func (c *GeminiClient) ListControls(engineName string) ([]*Control, error) {
+	collectionURL := c.controlsURL(engineName)
+
+	controls := []*Control{}
+	pageToken := ""
+	for {
+		pageURL := collectionURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("control", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			Controls      []*Control `json:"controls"`
+			NextPageToken string     `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode controls response: %w", err)
+		}
+		controls = append(controls, response.Controls...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Slice(controls, func(i, j int) bool {
+		return controls[i].Name < controls[j].Name
+	})
+	return controls, nil
+}
+
+// GetControl retrieves a control by resource name
+func (c *GeminiClient) GetControl(controlName string) (*Control, error) {
+	body, err := c.doAPIRequest("control", http.MethodGet, c.resourceURL(controlName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var control Control
+	if err := json.Unmarshal(body, &control); err != nil {
+		return nil, fmt.Errorf("failed to decode control response: %w", err)
+	}
+	return &control, nil
+}
+
+// CreateControl creates a control on an engine. The solution type defaults to
+// the engine's solution type.
+func (c *GeminiClient) CreateControl(engineName string, control *Control) (*Control, error) {
+	if control == nil {
+		return nil, fmt.Errorf("control is required")
+	}
+	if err := control.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := control.ExportForm()
+	payload.ID = ""
+	if payload.SolutionType == "" {
+		engine, err := c.GetEngineDetails(engineName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get engine details: %w", err)
+		}
+		payload.SolutionType = engine.SolutionType
+	}
+
+	createURL := fmt.Sprintf("%s?controlId=%s", c.controlsURL(engineName), url.QueryEscape(control.ControlID()))
+	body, err := c.doAPIRequest("control", http.MethodPost, createURL, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create control %s: %w", control.ControlID(), err)
+	}
+
+	var created Control
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode created control: %w", err)
+	}
+	return &created, nil
+}
+
+// UpdateControl patches a control using the provided update mask
+func (c *GeminiClient) UpdateControl(controlName string, control *Control, updateMask []string) (*Control, error) {
+	if control == nil {
+		return nil, fmt.Errorf("control update payload is required")
+	}
+
+	updateURL := c.resourceURL(controlName)
+	if len(updateMask) > 0 {
+		updateURL = fmt.Sprintf("%s?%s", updateURL, urlValuesFromMask(updateMask).Encode())
+	}
+
+	payload := control.ExportForm()
+	payload.ID = ""
+	payload.SolutionType = ""
+
+	body, err := c.doAPIRequest("control", http.MethodPatch, updateURL, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update control %s: %w", extractResourceID(controlName), err)
+	}
+
+	var updated Control
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode updated control: %w", err)
+	}
+	return &updated, nil
+}
+
+// DeleteControl deletes a control. Controls linked to serving configs cannot
+// be deleted; with unlink set they are unlinked first.
+func (c *GeminiClient) DeleteControl(controlName string, unlink bool) error {
+	control, err := c.GetControl(controlName)
+	if err != nil {
+		return err
+	}
+
+	if len(control.AssociatedServingConfigIds) > 0 {
+		if !unlink {
+			return fmt.Errorf("control %s is linked to serving config(s) %s; unlink it first",
+				extractResourceID(controlName), strings.Join(control.AssociatedServingConfigIds, ", "))
+		}
+		engineName := engineFromControlName(controlName)
+		for _, servingConfigID := range control.AssociatedServingConfigIds {
+			if _, err := c.UnlinkControl(ConstructServingConfigName(engineName, servingConfigID), control); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := c.doAPIRequest("control", http.MethodDelete, c.resourceURL(controlName), nil); err != nil {
+		return fmt.Errorf("failed to delete control %s: %w", extractResourceID(controlName), err)
+	}
+	return nil
+}
+
+// DiffControlFields returns the update mask needed to turn current into desired.
+func DiffControlFields(current, desired *Control) []string {
+	if desired == nil {
+		return nil
+	}
+	if current == nil {
+		current = &Control{}
+	}
+
+	mask := []string{}
+	if current.DisplayName != desired.DisplayName && desired.DisplayName != "" {
+		mask = append(mask, "displayName")
+	}
+	if !jsonValuesEqual(current.UseCases, desired.UseCases) {
+		mask = append(mask, "useCases")
+	}
+	if !jsonValuesEqual(current.Conditions, desired.Conditions) {
+		mask = append(mask, "conditions")
+	}
+	if !jsonValuesEqual(current.BoostAction, desired.BoostAction) {
+		mask = append(mask, "boostAction")
+	}
+	if !jsonValuesEqual(current.FilterAction, desired.FilterAction) {
+		mask = append(mask, "filterAction")
+	}
+	if !jsonValuesEqual(current.RedirectAction, desired.RedirectAction) {
+		mask = append(mask, "redirectAction")
+	}
+	if !jsonValuesEqual(current.SynonymsAction, desired.SynonymsAction) {
+		mask = append(mask, "synonymsAction")
+	}
+	if !jsonValuesEqual(current.PromoteAction, desired.PromoteAction) {
+		mask = append(mask, "promoteAction")
+	}
+	return mask
+}
+
+// DiffControls compares controls by ID. With prune, controls that exist only
+// in current are reported as removed.
+func DiffControls(current, desired []*Control, prune bool) []FieldDiff {
+	existing := controlsByID(current)
+	wanted := controlsByID(desired)
+
+	ids := make([]string, 0, len(existing)+len(wanted))
+	for id := range existing {
+		ids = append(ids, id)
+	}
+	for id := range wanted {
+		if _, ok := existing[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	diffs := []FieldDiff{}
+	for _, id := range ids {
+		from, inCurrent := existing[id]
+		to, inDesired := wanted[id]
+		field := "controls/" + id
+
+		switch {
+		case !inCurrent:
+			diffs = append(diffs, FieldDiff{Field: field, Old: "missing", New: to.ActionType()})
+		case !inDesired:
+			if prune {
+				diffs = append(diffs, FieldDiff{Field: field, Old: from.ActionType(), New: "missing"})
+			}
+		default:
+			if from.ActionType() != to.ActionType() {
+				diffs = append(diffs, FieldDiff{Field: field + ".actionType", Old: from.ActionType(),
+					New: to.ActionType() + " (cannot be changed; delete and re-create the control)"})
+				continue
+			}
+			for _, name := range DiffControlFields(from, to) {
+				diffs = append(diffs, FieldDiff{Field: field + "." + name, Old: controlFieldValue(from, name), New: controlFieldValue(to, name)})
+			}
+		}
+	}
+	return diffs
+}
+
+// checkControlActionTypes returns an error naming every control whose action
+// type differs between current and desired. The API cannot change the action
+// type of a control, so syncs check this before changing anything.
+func checkControlActionTypes(current, desired []*Control) error {
+	existing := controlsByID(current)
+
+	mismatches := []string{}
+	for _, control := range desired {
+		target, ok := existing[control.ControlID()]
+		if !ok || target.ActionType() == control.ActionType() {
+			continue
+		}
+		mismatches = append(mismatches, fmt.Sprintf("%s (%s to %s)", control.ControlID(), target.ActionType(), control.ActionType()))
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	sort.Strings(mismatches)
+	return fmt.Errorf("controls cannot change action type: %s; delete them and create them again", strings.Join(mismatches, ", "))
+}
+
+// SyncControls creates controls missing from the engine and updates those
+// that differ. Controls that are not in desired are left alone; use
+// PruneControls to remove them. Nothing is changed if any control would
+// change its action type.
+func (c *GeminiClient) SyncControls(engineName string, current, desired []*Control) ([]FieldDiff, error) {
+	if err := checkControlActionTypes(current, desired); err != nil {
+		return nil, err
+	}
+	existing := controlsByID(current)
+
+	changes := []FieldDiff{}
+	for _, control := range desired {
+		id := control.ControlID()
+		if id == "" {
+			continue
+		}
+		field := "controls/" + id
+
+		target, ok := existing[id]
+		if !ok {
+			if _, err := c.CreateControl(engineName, control); err != nil {
+				return changes, err
+			}
+			changes = append(changes, FieldDiff{Field: field, Old: "missing", New: "created"})
+			continue
+		}
+
+		mask := DiffControlFields(target, control)
+		if len(mask) == 0 {
+			continue
+		}
+		if _, err := c.UpdateControl(ConstructControlName(engineName, id), control, mask); err != nil {
+			return changes, err
+		}
+		for _, name := range mask {
+			changes = append(changes, FieldDiff{Field: field + "." + name, Old: controlFieldValue(target, name), New: controlFieldValue(control, name)})
+		}
+	}
+	return changes, nil
+}
+
+// PruneControls deletes controls in current that are not in desired,
+// unlinking them from serving configs first.
+func (c *GeminiClient) PruneControls(engineName string, current, desired []*Control) ([]FieldDiff, error) {
+	wanted := controlsByID(desired)
+
+	changes := []FieldDiff{}
+	for _, control := range current {
+		id := control.ControlID()
+		if _, ok := wanted[id]; ok || id == "" {
+			continue
+		}
+		if err := c.DeleteControl(ConstructControlName(engineName, id), true); err != nil {
+			return changes, err
+		}
+		changes = append(changes, FieldDiff{Field: "controls/" + id, Old: control.ActionType(), New: "deleted"})
+	}
+	return changes, nil
+}
+
+// ConstructControlName constructs the fully-qualified control resource name
+func ConstructControlName(engineName, controlID string) string {
+	if strings.Contains(controlID, "/") {
+		return controlID
+	}
+	return fmt.Sprintf("%s/controls/%s", strings.TrimPrefix(engineName, "/"), controlID)
+}
+
+func engineFromControlName(controlName string) string {
+	controlName = strings.TrimPrefix(controlName, "/")
+	if idx := strings.Index(controlName, "/controls/"); idx >= 0 {
+		return controlName[:idx]
+	}
+	return controlName
+}
+
+func (c *GeminiClient) controlsURL(engineName string) string {
+	return c.resourceURL(engineName) + "/controls"
+}
+
+func (c *GeminiClient) resourceURL(resourceName string) string {
+	base := strings.TrimRight(c.service.BasePath, "/")
+	return fmt.Sprintf("%s/v1alpha/%s", base, strings.TrimPrefix(resourceName, "/"))
+}
+
+func controlsByID(controls []*Control) map[string]*Control {
+	byID := make(map[string]*Control, len(controls))
+	for _, control := range controls {
+		if id := control.ControlID(); id != "" {
+			byID[id] = control
+		}
+	}
+	return byID
+}
+
+func controlFieldValue(control *Control, field string) interface{} {
+	values := map[string]interface{}{}
+	data, err := json.Marshal(control)
+	if err != nil {
+		return nil
+	}
+	_ = json.Unmarshal(data, &values)
+	return values[field]
+}
+
+func cloneControl(src *Control) *Control {
+	if src == nil {
+		return nil
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil
+	}
+	var dst Control
+	if err := json.Unmarshal(data, &dst); err != nil {
+		return nil
+	}
+	dst.ID = src.ID
+	return &dst
+}
@@ -196,7 +196,7 @@ func convertDataStore(ds *discoveryengine.GoogleCloudDiscoveryengineV1DataStore)
 		CreateTime:       ds.CreateTime,
 		SolutionTypes:    ds.SolutionTypes,
 		AclEnabled:       ds.AclEnabled,
-		DocumentProcessingConfig: make(map[string]interface{}),
+		DocumentProcessingConfig: convertDocumentProcessingConfig(ds.DocumentProcessingConfig),
 	}
 	
 	if ds.BillingEstimation != nil {
@@ -0,0 +1,387 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	// DenyListExactMatch blocks suggestions equal to the phrase.
+	DenyListExactMatch = "EXACT_MATCH"
+	// DenyListContains blocks suggestions containing the phrase.
+	DenyListContains = "CONTAINS"
+
+	// maxInlineCompletionEntries is the API limit for inline imports; larger
+	// lists must be imported from Cloud Storage.
+	maxInlineCompletionEntries = 1000
+)
+
+// DenyListEntry is a phrase blocked from autocomplete suggestions.
+type DenyListEntry struct {
+	BlockPhrase   string `json:"blockPhrase"`
+	MatchOperator string `json:"matchOperator,omitempty"`
+}
+
+// CompletionSuggestion is a custom autocomplete suggestion.
+type CompletionSuggestion struct {
+	Suggestion         string   `json:"suggestion"`
+	LanguageCode       string   `json:"languageCode,omitempty"`
+	GlobalScore        float64  `json:"globalScore,omitempty"`
+	Frequency          int64    `json:"frequency,omitempty"`
+	AlternativePhrases []string `json:"alternativePhrases,omitempty"`
+	GroupID            string   `json:"groupId,omitempty"`
+	GroupScore         float64  `json:"groupScore,omitempty"`
+}
+
+// CompletionImportResult reports the outcome of a deny-list or suggestion import.
+type CompletionImportResult struct {
+	Operation     string   `json:"operation"`
+	ImportedCount int64    `json:"importedCount"`
+	FailedCount   int64    `json:"failedCount"`
+	ErrorSamples  []string `json:"errorSamples,omitempty"`
+}
+
+// CompletionPurgeResult reports the outcome of a deny-list or suggestion purge.
+type CompletionPurgeResult struct {
+	Operation    string   `json:"operation"`
+	PurgedCount  int64    `json:"purgedCount,omitempty"`
+	ErrorSamples []string `json:"errorSamples,omitempty"`
+}
+
+// CompletionQueryOptions tunes a completeQuery request.
+type CompletionQueryOptions struct {
+	QueryModel             string
+	UserPseudoID           string
+	IncludeTailSuggestions bool
+}
+
+// QuerySuggestion is a suggestion returned by completeQuery.
+type QuerySuggestion struct {
+	Suggestion            string   `json:"suggestion"`
+	CompletableFieldPaths []string `json:"completableFieldPaths,omitempty"`
+}
+
+// CompletionQueryResult is the list of suggestions served for a prefix.
+type CompletionQueryResult struct {
+	Query              string             `json:"query"`
+	Suggestions        []*QuerySuggestion `json:"suggestions"`
+	TailMatchTriggered bool               `json:"tailMatchTriggered,omitempty"`
+}
+
+// ImportSuggestionDenyList replaces the data store's suggestion deny list with
+// the given entries, or with the file at gcsURI when set.
+func (c *GeminiClient) ImportSuggestionDenyList(dataStoreName string, entries []DenyListEntry, gcsURI string) (*CompletionImportResult, error) {
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1ImportSuggestionDenyListEntriesRequest{}
+	if gcsURI != "" {
+		request.GcsSource = &discoveryengine.GoogleCloudDiscoveryengineV1GcsSource{
+			InputUris:  []string{gcsURI},
+			DataSchema: "suggestion_deny_list",
+		}
+	} else {
+		if err := checkInlineCompletionCount(len(entries), "deny list entries"); err != nil {
+			return nil, err
+		}
+		inline := &discoveryengine.GoogleCloudDiscoveryengineV1ImportSuggestionDenyListEntriesRequestInlineSource{}
+		for _, entry := range entries {
+			operator, err := normalizeMatchOperator(entry.MatchOperator)
+			if err != nil {
+				return nil, fmt.Errorf("deny list entry %q: %w", entry.BlockPhrase, err)
+			}
+			inline.Entries = append(inline.Entries, &discoveryengine.GoogleCloudDiscoveryengineV1SuggestionDenyListEntry{
+				BlockPhrase:   entry.BlockPhrase,
+				MatchOperator: operator,
+			})
+		}
+		request.InlineSource = inline
+	}
+
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SuggestionDenyListEntries.
+		Import(dataStoreName, request).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to import suggestion deny list: %w", err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompletionImportResult{Operation: operation.Name}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1ImportSuggestionDenyListEntriesResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.ImportedCount = response.ImportedEntriesCount
+		result.FailedCount = response.FailedEntriesCount
+		result.ErrorSamples = rpcStatusMessages(response.ErrorSamples)
+	}
+	return result, nil
+}
+
+// PurgeSuggestionDenyList removes every deny list entry from the data store.
+func (c *GeminiClient) PurgeSuggestionDenyList(dataStoreName string) (*CompletionPurgeResult, error) {
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SuggestionDenyListEntries.
+		Purge(dataStoreName, &discoveryengine.GoogleCloudDiscoveryengineV1PurgeSuggestionDenyListEntriesRequest{}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge suggestion deny list: %w", err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompletionPurgeResult{Operation: operation.Name}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1PurgeSuggestionDenyListEntriesResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.PurgedCount = response.PurgeCount
+		result.ErrorSamples = rpcStatusMessages(response.ErrorSamples)
+	}
+	return result, nil
+}
+
+// ImportCompletionSuggestions imports custom autocomplete suggestions, either
+// inline or from the JSONL file at gcsURI when set.
+func (c *GeminiClient) ImportCompletionSuggestions(dataStoreName string, suggestions []CompletionSuggestion, gcsURI string) (*CompletionImportResult, error) {
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1ImportCompletionSuggestionsRequest{}
+	if gcsURI != "" {
+		request.GcsSource = &discoveryengine.GoogleCloudDiscoveryengineV1GcsSource{
+			InputUris: []string{gcsURI},
+		}
+	} else {
+		if err := checkInlineCompletionCount(len(suggestions), "suggestions"); err != nil {
+			return nil, err
+		}
+		inline := &discoveryengine.GoogleCloudDiscoveryengineV1ImportCompletionSuggestionsRequestInlineSource{}
+		for _, suggestion := range suggestions {
+			inline.Suggestions = append(inline.Suggestions, &discoveryengine.GoogleCloudDiscoveryengineV1CompletionSuggestion{
+				Suggestion:         suggestion.Suggestion,
+				LanguageCode:       suggestion.LanguageCode,
+				GlobalScore:        suggestion.GlobalScore,
+				Frequency:          suggestion.Frequency,
+				AlternativePhrases: suggestion.AlternativePhrases,
+				GroupId:            suggestion.GroupID,
+				GroupScore:         suggestion.GroupScore,
+			})
+		}
+		request.InlineSource = inline
+	}
+
+	operation, err := c.service.Projects.Locations.Collections.DataStores.CompletionSuggestions.
+		Import(dataStoreName, request).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to import completion suggestions: %w", err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompletionImportResult{Operation: operation.Name}
+	var metadata discoveryengine.GoogleCloudDiscoveryengineV1ImportCompletionSuggestionsMetadata
+	if err := status.DecodeMetadata(&metadata); err == nil {
+		result.ImportedCount = metadata.SuccessCount
+		result.FailedCount = metadata.FailureCount
+	}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1ImportCompletionSuggestionsResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.ErrorSamples = rpcStatusMessages(response.ErrorSamples)
+	}
+	return result, nil
+}
+
+// PurgeCompletionSuggestions removes every custom suggestion from the data store.
+func (c *GeminiClient) PurgeCompletionSuggestions(dataStoreName string) (*CompletionPurgeResult, error) {
+	operation, err := c.service.Projects.Locations.Collections.DataStores.CompletionSuggestions.
+		Purge(dataStoreName, &discoveryengine.GoogleCloudDiscoveryengineV1PurgeCompletionSuggestionsRequest{}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge completion suggestions: %w", err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CompletionPurgeResult{Operation: operation.Name}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1PurgeCompletionSuggestionsResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.ErrorSamples = rpcStatusMessages(response.ErrorSamples)
+		if !response.PurgeSucceeded && len(result.ErrorSamples) > 0 {
+			return result, fmt.Errorf("purge of completion suggestions did not succeed: %s", result.ErrorSamples[0])
+		}
+	}
+	return result, nil
+}
+
+// CompleteQuery returns the autocomplete suggestions served for a prefix.
+func (c *GeminiClient) CompleteQuery(dataStoreName, query string, opts CompletionQueryOptions) (*CompletionQueryResult, error) {
+	call := c.service.Projects.Locations.Collections.DataStores.CompleteQuery(dataStoreName).Query(query)
+	if opts.QueryModel != "" {
+		call.QueryModel(opts.QueryModel)
+	}
+	if opts.UserPseudoID != "" {
+		call.UserPseudoId(opts.UserPseudoID)
+	}
+	if opts.IncludeTailSuggestions {
+		call.IncludeTailSuggestions(true)
+	}
+
+	response, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete query %q: %w", query, err)
+	}
+
+	result := &CompletionQueryResult{
+		Query:              query,
+		Suggestions:        []*QuerySuggestion{},
+		TailMatchTriggered: response.TailMatchTriggered,
+	}
+	for _, suggestion := range response.QuerySuggestions {
+		result.Suggestions = append(result.Suggestions, &QuerySuggestion{
+			Suggestion:            suggestion.Suggestion,
+			CompletableFieldPaths: suggestion.CompletableFieldPaths,
+		})
+	}
+	return result, nil
+}
+
+// LoadDenyListFile reads deny list entries from a local file. Files ending in
+// .json or .jsonl hold one {"blockPhrase", "matchOperator"} object per line;
+// any other file holds one phrase per line and uses defaultOperator. Blank
+// lines and lines starting with # are ignored.
+func LoadDenyListFile(path, defaultOperator string) ([]DenyListEntry, error) {
+	operator, err := normalizeMatchOperator(defaultOperator)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []DenyListEntry{}
+	err = scanCompletionFile(path, func(line string) error {
+		entry := DenyListEntry{BlockPhrase: line}
+		if isJSONLinesFile(path) {
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				return fmt.Errorf("invalid deny list entry: %w", err)
+			}
+		}
+		if strings.TrimSpace(entry.BlockPhrase) == "" {
+			return fmt.Errorf("blockPhrase is required")
+		}
+		if entry.MatchOperator == "" {
+			entry.MatchOperator = operator
+		}
+		if entry.MatchOperator, err = normalizeMatchOperator(entry.MatchOperator); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no deny list entries found in %s", path)
+	}
+	return entries, nil
+}
+
+// LoadCompletionSuggestionsFile reads custom suggestions from a local file.
+// Files ending in .json or .jsonl hold one suggestion object per line; any
+// other file holds one suggestion text per line. languageCode is applied to
+// suggestions that do not set one. Blank lines and lines starting with # are
+// ignored.
+func LoadCompletionSuggestionsFile(path, languageCode string) ([]CompletionSuggestion, error) {
+	suggestions := []CompletionSuggestion{}
+	err := scanCompletionFile(path, func(line string) error {
+		suggestion := CompletionSuggestion{Suggestion: line}
+		if isJSONLinesFile(path) {
+			if err := json.Unmarshal([]byte(line), &suggestion); err != nil {
+				return fmt.Errorf("invalid suggestion: %w", err)
+			}
+		}
+		if strings.TrimSpace(suggestion.Suggestion) == "" {
+			return fmt.Errorf("suggestion is required")
+		}
+		if suggestion.LanguageCode == "" {
+			suggestion.LanguageCode = languageCode
+		}
+		suggestions = append(suggestions, suggestion)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) == 0 {
+		return nil, fmt.Errorf("no suggestions found in %s", path)
+	}
+	return suggestions, nil
+}
+
+func scanCompletionFile(path string, handle func(line string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := handle(line); err != nil {
+			return fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
+
+func isJSONLinesFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".jsonl", ".ndjson":
+		return true
+	}
+	return false
+}
+
+func normalizeMatchOperator(operator string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(operator)) {
+	case "", "EXACT", DenyListExactMatch:
+		return DenyListExactMatch, nil
+	case DenyListContains:
+		return DenyListContains, nil
+	}
+	return "", fmt.Errorf("invalid match operator %q (use exact or contains)", operator)
+}
+
+func checkInlineCompletionCount(count int, what string) error {
+	if count == 0 {
+		return fmt.Errorf("no %s to import", what)
+	}
+	if count > maxInlineCompletionEntries {
+		return fmt.Errorf("%d %s exceed the inline import limit of %d; upload the file to Cloud Storage and use a gs:// URI",
+			count, what, maxInlineCompletionEntries)
+	}
+	return nil
+}
+
+func rpcStatusMessages(statuses []*discoveryengine.GoogleRpcStatus) []string {
+	var messages []string
+	for _, status := range statuses {
+		if status != nil && status.Message != "" {
+			messages = append(messages, status.Message)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,312 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/discoveryengine/v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	minLayoutChunkSize = 100
+	maxLayoutChunkSize = 500
+)
+
+// DataStoreSpec describes a data store to create. It can be loaded from YAML
+// and overridden by command-line flags.
+type DataStoreSpec struct {
+	ID                 string                  `json:"id" yaml:"id"`
+	DisplayName        string                  `json:"displayName" yaml:"displayName"`
+	IndustryVertical   string                  `json:"industryVertical,omitempty" yaml:"industryVertical,omitempty"`
+	ContentConfig      string                  `json:"contentConfig,omitempty" yaml:"contentConfig,omitempty"`
+	SolutionTypes      []string                `json:"solutionTypes,omitempty" yaml:"solutionTypes,omitempty"`
+	AclEnabled         bool                    `json:"aclEnabled,omitempty" yaml:"aclEnabled,omitempty"`
+	AdvancedSiteSearch bool                    `json:"advancedSiteSearch,omitempty" yaml:"advancedSiteSearch,omitempty"`
+	Workspace          *DataStoreWorkspaceSpec `json:"workspace,omitempty" yaml:"workspace,omitempty"`
+	DocumentProcessing *DocumentProcessingSpec `json:"documentProcessing,omitempty" yaml:"documentProcessing,omitempty"`
+}
+
+// DataStoreWorkspaceSpec configures a Google Workspace data store.
+type DataStoreWorkspaceSpec struct {
+	Type                     string `json:"type" yaml:"type"`
+	DasherCustomerID         string `json:"dasherCustomerId,omitempty" yaml:"dasherCustomerId,omitempty"`
+	SuperAdminServiceAccount string `json:"superAdminServiceAccount,omitempty" yaml:"superAdminServiceAccount,omitempty"`
+	SuperAdminEmailAddress   string `json:"superAdminEmailAddress,omitempty" yaml:"superAdminEmailAddress,omitempty"`
+}
+
+// DocumentProcessingSpec describes how documents are parsed and chunked.
+type DocumentProcessingSpec struct {
+	Parser                  string `json:"parser,omitempty" yaml:"parser,omitempty"`
+	UseNativeText           bool   `json:"useNativeText,omitempty" yaml:"useNativeText,omitempty"`
+	EnableTableAnnotation   bool   `json:"enableTableAnnotation,omitempty" yaml:"enableTableAnnotation,omitempty"`
+	EnableImageAnnotation   bool   `json:"enableImageAnnotation,omitempty" yaml:"enableImageAnnotation,omitempty"`
+	ChunkSize               int64  `json:"chunkSize,omitempty" yaml:"chunkSize,omitempty"`
+	IncludeAncestorHeadings bool   `json:"includeAncestorHeadings,omitempty" yaml:"includeAncestorHeadings,omitempty"`
+}
+
+var (
+	industryVerticalAliases = map[string]string{
+		"generic":         "GENERIC",
+		"media":           "MEDIA",
+		"healthcare-fhir": "HEALTHCARE_FHIR",
+		"healthcare":      "HEALTHCARE_FHIR",
+	}
+	contentConfigAliases = map[string]string{
+		"no-content":       "NO_CONTENT",
+		"structured":       "NO_CONTENT",
+		"content-required": "CONTENT_REQUIRED",
+		"unstructured":     "CONTENT_REQUIRED",
+		"public-website":   "PUBLIC_WEBSITE",
+		"website":          "PUBLIC_WEBSITE",
+		"google-workspace": "GOOGLE_WORKSPACE",
+		"workspace":        "GOOGLE_WORKSPACE",
+	}
+	solutionTypeAliases = map[string]string{
+		"search":          "SOLUTION_TYPE_SEARCH",
+		"recommendation":  "SOLUTION_TYPE_RECOMMENDATION",
+		"chat":            "SOLUTION_TYPE_CHAT",
+		"generative-chat": "SOLUTION_TYPE_GENERATIVE_CHAT",
+	}
+	workspaceTypeAliases = map[string]string{
+		"drive":    "GOOGLE_DRIVE",
+		"mail":     "GOOGLE_MAIL",
+		"gmail":    "GOOGLE_MAIL",
+		"sites":    "GOOGLE_SITES",
+		"calendar": "GOOGLE_CALENDAR",
+		"chat":     "GOOGLE_CHAT",
+		"groups":   "GOOGLE_GROUPS",
+		"keep":     "GOOGLE_KEEP",
+		"people":   "GOOGLE_PEOPLE",
+	}
+	documentParserAliases = map[string]string{
+		"digital": "digital",
+		"ocr":     "ocr",
+		"layout":  "layout",
+	}
+)
+
+// LoadDataStoreSpec reads a data store spec from a YAML (or JSON) file.
+func LoadDataStoreSpec(path string) (*DataStoreSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data store spec %s: %w", path, err)
+	}
+
+	var spec DataStoreSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse data store spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// Normalize applies defaults, converts friendly values (e.g. healthcare-fhir,
+// no-content) into API enums, and validates the combination of settings.
+func (s *DataStoreSpec) Normalize() error {
+	if strings.TrimSpace(s.ID) == "" {
+		return fmt.Errorf("data store ID is required")
+	}
+	if strings.TrimSpace(s.DisplayName) == "" {
+		s.DisplayName = s.ID
+	}
+
+	var err error
+	if s.IndustryVertical, err = normalizeEnum(s.IndustryVertical, "GENERIC", industryVerticalAliases, "industry vertical"); err != nil {
+		return err
+	}
+	if s.ContentConfig, err = normalizeEnum(s.ContentConfig, "CONTENT_REQUIRED", contentConfigAliases, "content config"); err != nil {
+		return err
+	}
+
+	if len(s.SolutionTypes) == 0 {
+		s.SolutionTypes = []string{"SOLUTION_TYPE_SEARCH"}
+	}
+	for i, solutionType := range s.SolutionTypes {
+		if s.SolutionTypes[i], err = normalizeEnum(solutionType, "", solutionTypeAliases, "solution type"); err != nil {
+			return err
+		}
+	}
+
+	if s.Workspace != nil {
+		if s.Workspace.Type, err = normalizeEnum(s.Workspace.Type, "", workspaceTypeAliases, "workspace type"); err != nil {
+			return err
+		}
+		if s.Workspace.Type == "" {
+			return fmt.Errorf("workspace type is required for workspace data stores")
+		}
+	}
+
+	if s.DocumentProcessing != nil {
+		if err := s.DocumentProcessing.Normalize(); err != nil {
+			return err
+		}
+	}
+
+	switch s.ContentConfig {
+	case "GOOGLE_WORKSPACE":
+		if s.Workspace == nil {
+			return fmt.Errorf("google-workspace data stores require a workspace type")
+		}
+	case "PUBLIC_WEBSITE":
+		if s.DocumentProcessing != nil && s.DocumentProcessing.Parser == "ocr" {
+			return fmt.Errorf("the ocr parser is not supported for public-website data stores")
+		}
+	default:
+		if s.AdvancedSiteSearch {
+			return fmt.Errorf("advanced site search requires a public-website data store")
+		}
+	}
+	if s.Workspace != nil && s.ContentConfig != "GOOGLE_WORKSPACE" {
+		return fmt.Errorf("workspace settings require the google-workspace content config")
+	}
+	if s.IndustryVertical == "HEALTHCARE_FHIR" && s.ContentConfig == "PUBLIC_WEBSITE" {
+		return fmt.Errorf("healthcare-fhir data stores cannot index public websites")
+	}
+
+	return nil
+}
+
+// Normalize validates parser and chunking settings.
+func (d *DocumentProcessingSpec) Normalize() error {
+	var err error
+	if d.Parser, err = normalizeEnum(d.Parser, "", documentParserAliases, "document parser"); err != nil {
+		return err
+	}
+
+	chunking := d.ChunkSize > 0 || d.IncludeAncestorHeadings
+	if chunking && d.Parser != "layout" {
+		return fmt.Errorf("chunking requires the layout parser")
+	}
+	if d.ChunkSize != 0 && (d.ChunkSize < minLayoutChunkSize || d.ChunkSize > maxLayoutChunkSize) {
+		return fmt.Errorf("chunk size must be between %d and %d tokens", minLayoutChunkSize, maxLayoutChunkSize)
+	}
+	if d.UseNativeText && d.Parser != "ocr" {
+		return fmt.Errorf("use-native-text requires the ocr parser")
+	}
+	if (d.EnableTableAnnotation || d.EnableImageAnnotation) && d.Parser != "layout" {
+		return fmt.Errorf("table and image annotation require the layout parser")
+	}
+	return nil
+}
+
+// IsEmpty reports whether the spec sets no document processing options.
+func (d *DocumentProcessingSpec) IsEmpty() bool {
+	return d == nil || *d == DocumentProcessingSpec{}
+}
+
+// CreateDataStore creates a data store from spec. When wait is true the
+// creation operation is polled until the data store is ready.
+func (c *GeminiClient) CreateDataStore(spec *DataStoreSpec, wait bool) (*DataStore, error) {
+	if err := spec.Normalize(); err != nil {
+		return nil, err
+	}
+
+	collectionName := fmt.Sprintf("projects/%s/locations/%s/collections/%s",
+		c.config.ProjectID, c.config.Location, c.config.Collection)
+
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1DataStore{
+		DisplayName:      spec.DisplayName,
+		IndustryVertical: spec.IndustryVertical,
+		ContentConfig:    spec.ContentConfig,
+		SolutionTypes:    spec.SolutionTypes,
+		AclEnabled:       spec.AclEnabled,
+	}
+	if spec.Workspace != nil {
+		request.WorkspaceConfig = &discoveryengine.GoogleCloudDiscoveryengineV1WorkspaceConfig{
+			Type:                     spec.Workspace.Type,
+			DasherCustomerId:         spec.Workspace.DasherCustomerID,
+			SuperAdminServiceAccount: spec.Workspace.SuperAdminServiceAccount,
+			SuperAdminEmailAddress:   spec.Workspace.SuperAdminEmailAddress,
+		}
+	}
+	if !spec.DocumentProcessing.IsEmpty() {
+		request.DocumentProcessingConfig = buildDocumentProcessingConfig(spec.DocumentProcessing)
+	}
+
+	call := c.service.Projects.Locations.Collections.DataStores.Create(collectionName, request)
+	call.DataStoreId(spec.ID)
+	if spec.AdvancedSiteSearch {
+		call.CreateAdvancedSiteSearch(true)
+	}
+
+	operation, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data store: %w", err)
+	}
+
+	dataStoreName := fmt.Sprintf("%s/dataStores/%s", collectionName, spec.ID)
+	if !wait {
+		return &DataStore{
+			Name:             dataStoreName,
+			DisplayName:      spec.DisplayName,
+			IndustryVertical: spec.IndustryVertical,
+			ContentConfig:    spec.ContentConfig,
+			SolutionTypes:    spec.SolutionTypes,
+			AclEnabled:       spec.AclEnabled,
+		}, nil
+	}
+
+	if _, err := c.waitForDataStoreCreation(operation.Name, spec.ID); err != nil {
+		return nil, err
+	}
+	return c.GetDataStoreDetails(dataStoreName)
+}
+
+func buildDocumentProcessingConfig(spec *DocumentProcessingSpec) *discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfig {
+	config := &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfig{}
+
+	parsing := &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigParsingConfig{}
+	switch spec.Parser {
+	case "digital":
+		parsing.DigitalParsingConfig = &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigParsingConfigDigitalParsingConfig{}
+	case "ocr":
+		parsing.OcrParsingConfig = &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigParsingConfigOcrParsingConfig{
+			UseNativeText: spec.UseNativeText,
+		}
+	case "layout":
+		parsing.LayoutParsingConfig = &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigParsingConfigLayoutParsingConfig{
+			EnableTableAnnotation: spec.EnableTableAnnotation,
+			EnableImageAnnotation: spec.EnableImageAnnotation,
+		}
+	}
+	if spec.Parser != "" {
+		config.DefaultParsingConfig = parsing
+	}
+
+	if spec.ChunkSize > 0 || spec.IncludeAncestorHeadings {
+		config.ChunkingConfig = &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigChunkingConfig{
+			LayoutBasedChunkingConfig: &discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfigChunkingConfigLayoutBasedChunkingConfig{
+				ChunkSize:               spec.ChunkSize,
+				IncludeAncestorHeadings: spec.IncludeAncestorHeadings,
+			},
+		}
+	}
+	return config
+}
+
+// normalizeEnum maps a friendly alias (or an API enum value) to the API enum.
+// Empty values return fallback.
+func normalizeEnum(value, fallback string, aliases map[string]string, kind string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return fallback, nil
+	}
+	if enum, ok := aliases[strings.ToLower(trimmed)]; ok {
+		return enum, nil
+	}
+	upper := strings.ToUpper(trimmed)
+	for _, enum := range aliases {
+		if enum == upper {
+			return enum, nil
+		}
+	}
+
+	names := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	return "", fmt.Errorf("invalid %s %q (use %s)", kind, value, strings.Join(names, ", "))
+}
@@ -0,0 +1,254 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+// DocumentProcessingConfig describes how a data store parses and chunks documents.
+type DocumentProcessingConfig struct {
+	Name                   string                            `json:"name,omitempty" yaml:"name,omitempty"`
+	DefaultParsingConfig   *DocumentParsingConfig            `json:"defaultParsingConfig,omitempty" yaml:"defaultParsingConfig,omitempty"`
+	ParsingConfigOverrides map[string]*DocumentParsingConfig `json:"parsingConfigOverrides,omitempty" yaml:"parsingConfigOverrides,omitempty"`
+	ChunkingConfig         *DocumentChunkingConfig           `json:"chunkingConfig,omitempty" yaml:"chunkingConfig,omitempty"`
+}
+
+// DocumentParsingConfig selects the digital, OCR, or layout parser.
+type DocumentParsingConfig struct {
+	DigitalParsingConfig *struct{}                 `json:"digitalParsingConfig,omitempty" yaml:"digitalParsingConfig,omitempty"`
+	OcrParsingConfig     *DocumentOcrParsingConfig `json:"ocrParsingConfig,omitempty" yaml:"ocrParsingConfig,omitempty"`
+	LayoutParsingConfig  *DocumentLayoutParsing    `json:"layoutParsingConfig,omitempty" yaml:"layoutParsingConfig,omitempty"`
+}
+
+// DocumentOcrParsingConfig holds OCR parser options.
+type DocumentOcrParsingConfig struct {
+	UseNativeText bool `json:"useNativeText,omitempty" yaml:"useNativeText,omitempty"`
+}
+
+// DocumentLayoutParsing holds layout parser options.
+type DocumentLayoutParsing struct {
+	EnableTableAnnotation bool `json:"enableTableAnnotation,omitempty" yaml:"enableTableAnnotation,omitempty"`
+	EnableImageAnnotation bool `json:"enableImageAnnotation,omitempty" yaml:"enableImageAnnotation,omitempty"`
+}
+
+// DocumentChunkingConfig holds layout-based chunking options.
+type DocumentChunkingConfig struct {
+	LayoutBasedChunkingConfig *DocumentLayoutChunking `json:"layoutBasedChunkingConfig,omitempty" yaml:"layoutBasedChunkingConfig,omitempty"`
+}
+
+// DocumentLayoutChunking holds the chunk size and heading options.
+type DocumentLayoutChunking struct {
+	ChunkSize               int64 `json:"chunkSize,omitempty" yaml:"chunkSize,omitempty"`
+	IncludeAncestorHeadings bool  `json:"includeAncestorHeadings,omitempty" yaml:"includeAncestorHeadings,omitempty"`
+}
+
+// DocumentProcessingUpdate describes parser changes for an existing data store.
+type DocumentProcessingUpdate struct {
+	Default         *DocumentProcessingSpec
+	Overrides       map[string]*DocumentProcessingSpec
+	RemoveOverrides []string
+}
+
+// DocumentParserFileTypes lists the file types that accept parser overrides.
+var DocumentParserFileTypes = []string{"docx", "html", "pdf", "pptx", "xlsm", "xlsx"}
+
+// Parser returns digital, ocr, layout, or an empty string when unset.
+func (p *DocumentParsingConfig) Parser() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.LayoutParsingConfig != nil:
+		return "layout"
+	case p.OcrParsingConfig != nil:
+		return "ocr"
+	case p.DigitalParsingConfig != nil:
+		return "digital"
+	default:
+		return ""
+	}
+}
+
+// LayoutChunking returns the layout-based chunking settings, or nil when chunking is off.
+func (c *DocumentProcessingConfig) LayoutChunking() *DocumentLayoutChunking {
+	if c == nil || c.ChunkingConfig == nil {
+		return nil
+	}
+	return c.ChunkingConfig.LayoutBasedChunkingConfig
+}
+
+// GetDocumentProcessingConfig returns the document processing config of a data store.
+func (c *GeminiClient) GetDocumentProcessingConfig(dataStoreName string) (*DocumentProcessingConfig, error) {
+	dataStore, err := c.service.Projects.Locations.Collections.DataStores.Get(dataStoreName).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data store: %w", err)
+	}
+	config := convertDocumentProcessingConfig(dataStore.DocumentProcessingConfig)
+	if config == nil {
+		config = &DocumentProcessingConfig{}
+	}
+	if config.Name == "" {
+		config.Name = dataStoreName + "/documentProcessingConfig"
+	}
+	return config, nil
+}
+
+// UpdateDocumentProcessingConfig changes the default parser and per-file-type
+// parser overrides of a data store. Chunking settings are fixed when a data
+// store is created and cannot be changed here.
+func (c *GeminiClient) UpdateDocumentProcessingConfig(dataStoreName string, update *DocumentProcessingUpdate) (*DocumentProcessingConfig, error) {
+	dataStore, err := c.service.Projects.Locations.Collections.DataStores.Get(dataStoreName).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data store: %w", err)
+	}
+
+	current := convertDocumentProcessingConfig(dataStore.DocumentProcessingConfig)
+	if current == nil {
+		current = &DocumentProcessingConfig{}
+	}
+	desired, mask, err := planDocumentProcessingUpdate(current, dataStore.ContentConfig, update)
+	if err != nil {
+		return nil, err
+	}
+	if len(mask) == 0 {
+		return current, nil
+	}
+
+	base := strings.TrimRight(c.service.BasePath, "/")
+	url := fmt.Sprintf("%s/v1alpha/%s/documentProcessingConfig?%s", base, dataStoreName, urlValuesFromMask(mask).Encode())
+	desired.Name = ""
+
+	body, err := c.doAPIRequest("document processing config", http.MethodPatch, url, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated DocumentProcessingConfig
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode document processing config: %w", err)
+	}
+	return &updated, nil
+}
+
+func planDocumentProcessingUpdate(current *DocumentProcessingConfig, contentConfig string, update *DocumentProcessingUpdate) (*DocumentProcessingConfig, []string, error) {
+	if update == nil {
+		return nil, nil, fmt.Errorf("no document processing changes requested")
+	}
+
+	desired := &DocumentProcessingConfig{
+		Name:                   current.Name,
+		DefaultParsingConfig:   current.DefaultParsingConfig,
+		ParsingConfigOverrides: map[string]*DocumentParsingConfig{},
+		ChunkingConfig:         current.ChunkingConfig,
+	}
+	for fileType, parsing := range current.ParsingConfigOverrides {
+		desired.ParsingConfigOverrides[fileType] = parsing
+	}
+
+	mask := []string{}
+	if spec := update.Default; spec != nil {
+		if spec.ChunkSize != 0 || spec.IncludeAncestorHeadings {
+			return nil, nil, fmt.Errorf("chunking settings cannot be changed after a data store is created; create a new data store with the desired --chunk-size and --ancestor-headings and re-import the documents")
+		}
+		if err := spec.Normalize(); err != nil {
+			return nil, nil, err
+		}
+		if spec.Parser != "" {
+			if current.LayoutChunking() != nil && spec.Parser != "layout" {
+				return nil, nil, fmt.Errorf("the default parser of a data store with chunking enabled must remain layout; chunking cannot be disabled after creation")
+			}
+			if spec.Parser == "ocr" && contentConfig == "PUBLIC_WEBSITE" {
+				return nil, nil, fmt.Errorf("the ocr parser is not supported for public-website data stores")
+			}
+			desired.DefaultParsingConfig = parsingConfigFromSpec(spec)
+			mask = append(mask, "defaultParsingConfig")
+		}
+	}
+
+	overridesChanged := false
+	fileTypes := make([]string, 0, len(update.Overrides))
+	for fileType := range update.Overrides {
+		fileTypes = append(fileTypes, fileType)
+	}
+	sort.Strings(fileTypes)
+	for _, fileType := range fileTypes {
+		normalized, err := normalizeParserFileType(fileType)
+		if err != nil {
+			return nil, nil, err
+		}
+		spec := update.Overrides[fileType]
+		if spec.ChunkSize != 0 || spec.IncludeAncestorHeadings {
+			return nil, nil, fmt.Errorf("chunking cannot be configured per file type")
+		}
+		if err := spec.Normalize(); err != nil {
+			return nil, nil, fmt.Errorf("override %s: %w", normalized, err)
+		}
+		if spec.Parser == "" {
+			return nil, nil, fmt.Errorf("override %s: a parser is required", normalized)
+		}
+		if spec.Parser == "ocr" && normalized != "pdf" {
+			return nil, nil, fmt.Errorf("override %s: the ocr parser only supports pdf files", normalized)
+		}
+		desired.ParsingConfigOverrides[normalized] = parsingConfigFromSpec(spec)
+		overridesChanged = true
+	}
+	for _, fileType := range update.RemoveOverrides {
+		normalized, err := normalizeParserFileType(fileType)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := desired.ParsingConfigOverrides[normalized]; !ok {
+			return nil, nil, fmt.Errorf("no parser override is set for %s", normalized)
+		}
+		delete(desired.ParsingConfigOverrides, normalized)
+		overridesChanged = true
+	}
+	if overridesChanged {
+		mask = append(mask, "parsingConfigOverrides")
+	}
+
+	return desired, mask, nil
+}
+
+func parsingConfigFromSpec(spec *DocumentProcessingSpec) *DocumentParsingConfig {
+	switch spec.Parser {
+	case "ocr":
+		return &DocumentParsingConfig{OcrParsingConfig: &DocumentOcrParsingConfig{UseNativeText: spec.UseNativeText}}
+	case "layout":
+		return &DocumentParsingConfig{LayoutParsingConfig: &DocumentLayoutParsing{
+			EnableTableAnnotation: spec.EnableTableAnnotation,
+			EnableImageAnnotation: spec.EnableImageAnnotation,
+		}}
+	default:
+		return &DocumentParsingConfig{DigitalParsingConfig: &struct{}{}}
+	}
+}
+
+func normalizeParserFileType(fileType string) (string, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(fileType), "."))
+	for _, known := range DocumentParserFileTypes {
+		if normalized == known {
+			return normalized, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported file type %q for parser overrides (use %s)", fileType, strings.Join(DocumentParserFileTypes, ", "))
+}
+
+func convertDocumentProcessingConfig(config *discoveryengine.GoogleCloudDiscoveryengineV1DocumentProcessingConfig) *DocumentProcessingConfig {
+	if config == nil {
+		return nil
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil
+	}
+	var result DocumentProcessingConfig
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil
+	}
+	return &result
+}
@@ -0,0 +1,369 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	// TargetSiteInclude indexes pages matching the pattern.
+	TargetSiteInclude = "INCLUDE"
+	// TargetSiteExclude excludes pages matching the pattern from the index.
+	TargetSiteExclude = "EXCLUDE"
+
+	maxTargetSitesPerBatch = 20
+)
+
+// TargetSite is a URI pattern indexed (or excluded) by a website data store.
+type TargetSite struct {
+	Name                string `json:"name"`
+	ID                  string `json:"id"`
+	URIPattern          string `json:"uriPattern"`
+	GeneratedURIPattern string `json:"generatedUriPattern,omitempty"`
+	RootDomainURI       string `json:"rootDomainUri,omitempty"`
+	Type                string `json:"type"`
+	ExactMatch          bool   `json:"exactMatch,omitempty"`
+	IndexingStatus      string `json:"indexingStatus,omitempty"`
+	VerificationState   string `json:"verificationState,omitempty"`
+	VerifyTime          string `json:"verifyTime,omitempty"`
+	UpdateTime          string `json:"updateTime,omitempty"`
+	FailureReason       string `json:"failureReason,omitempty"`
+}
+
+// TargetSiteSpec describes a target site to add.
+type TargetSiteSpec struct {
+	URIPattern string `json:"uriPattern" yaml:"uriPattern"`
+	Type       string `json:"type,omitempty" yaml:"type,omitempty"`
+	ExactMatch bool   `json:"exactMatch,omitempty" yaml:"exactMatch,omitempty"`
+}
+
+// RecrawlStatus reports the progress of a recrawl operation.
+type RecrawlStatus struct {
+	Operation                  string   `json:"operation"`
+	Done                       bool     `json:"done"`
+	Error                      string   `json:"error,omitempty"`
+	ValidURIs                  int64    `json:"validUris"`
+	SuccessCount               int64    `json:"successCount"`
+	PendingCount               int64    `json:"pendingCount"`
+	QuotaExceededCount         int64    `json:"quotaExceededCount,omitempty"`
+	InvalidURIs                []string `json:"invalidUris,omitempty"`
+	NoindexURIs                []string `json:"noindexUris,omitempty"`
+	URIsNotMatchingTargetSites []string `json:"urisNotMatchingTargetSites,omitempty"`
+	FailedURIs                 []string `json:"failedUris,omitempty"`
+}
+
+// ListTargetSites lists the target sites of a website data store.
+func (c *GeminiClient) ListTargetSites(dataStoreName string) ([]*TargetSite, error) {
+	sites := []*TargetSite{}
+	call := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.TargetSites.List(siteSearchEngineName(dataStoreName))
+	for {
+		response, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list target sites: %w", err)
+		}
+		for _, site := range response.TargetSites {
+			sites = append(sites, convertTargetSite(site))
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	sort.Slice(sites, func(i, j int) bool {
+		return sites[i].URIPattern < sites[j].URIPattern
+	})
+	return sites, nil
+}
+
+// AddTargetSite adds a target site and waits for it to be registered.
+func (c *GeminiClient) AddTargetSite(dataStoreName string, spec TargetSiteSpec) (*TargetSite, error) {
+	request, err := targetSiteRequest(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.TargetSites.
+		Create(siteSearchEngineName(dataStoreName), request).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to add target site %s: %w", spec.URIPattern, err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var created discoveryengine.GoogleCloudDiscoveryengineV1TargetSite
+	if err := status.DecodeResponse(&created); err != nil || created.Name == "" {
+		return convertTargetSite(request), nil
+	}
+	return convertTargetSite(&created), nil
+}
+
+// BatchAddTargetSites adds target sites in batches of 20 and waits for each
+// batch to be registered.
+func (c *GeminiClient) BatchAddTargetSites(dataStoreName string, specs []TargetSiteSpec) ([]*TargetSite, error) {
+	parent := siteSearchEngineName(dataStoreName)
+	sites := []*TargetSite{}
+
+	for start := 0; start < len(specs); start += maxTargetSitesPerBatch {
+		end := start + maxTargetSitesPerBatch
+		if end > len(specs) {
+			end = len(specs)
+		}
+
+		batch := &discoveryengine.GoogleCloudDiscoveryengineV1BatchCreateTargetSitesRequest{}
+		for _, spec := range specs[start:end] {
+			request, err := targetSiteRequest(spec)
+			if err != nil {
+				return sites, err
+			}
+			batch.Requests = append(batch.Requests, &discoveryengine.GoogleCloudDiscoveryengineV1CreateTargetSiteRequest{
+				Parent:     parent,
+				TargetSite: request,
+			})
+		}
+
+		operation, err := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.TargetSites.
+			BatchCreate(parent, batch).Do()
+		if err != nil {
+			return sites, fmt.Errorf("failed to add target sites %d-%d: %w", start+1, end, err)
+		}
+
+		status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+		if err != nil {
+			return sites, err
+		}
+
+		var response discoveryengine.GoogleCloudDiscoveryengineV1BatchCreateTargetSitesResponse
+		if err := status.DecodeResponse(&response); err != nil {
+			return sites, fmt.Errorf("failed to decode batch response: %w", err)
+		}
+		for _, site := range response.TargetSites {
+			sites = append(sites, convertTargetSite(site))
+		}
+	}
+
+	return sites, nil
+}
+
+// RemoveTargetSite removes a target site identified by its ID or URI pattern.
+func (c *GeminiClient) RemoveTargetSite(dataStoreName, siteRef string) (*TargetSite, error) {
+	site, err := c.findTargetSite(dataStoreName, siteRef)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.TargetSites.Delete(site.Name).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove target site %s: %w", site.URIPattern, err)
+	}
+	if _, err := c.WaitForOperation(operation.Name, 0, 0, nil); err != nil {
+		return nil, err
+	}
+	return site, nil
+}
+
+// VerifyTargetSites starts domain ownership verification for all target sites
+// and returns their verification status once the check completes.
+func (c *GeminiClient) VerifyTargetSites(dataStoreName string) ([]*TargetSite, error) {
+	engineName := siteSearchEngineName(dataStoreName)
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.
+		BatchVerifyTargetSites(engineName, &discoveryengine.GoogleCloudDiscoveryengineV1BatchVerifyTargetSitesRequest{}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify target sites: %w", err)
+	}
+	if _, err := c.WaitForOperation(operation.Name, 0, 0, nil); err != nil {
+		return nil, err
+	}
+
+	sites := []*TargetSite{}
+	call := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.FetchDomainVerificationStatus(engineName)
+	for {
+		response, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch domain verification status: %w", err)
+		}
+		for _, site := range response.TargetSites {
+			sites = append(sites, convertTargetSite(site))
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	sort.Slice(sites, func(i, j int) bool {
+		return sites[i].URIPattern < sites[j].URIPattern
+	})
+	return sites, nil
+}
+
+// RecrawlURIs requests a recrawl of the given URIs and returns the operation name.
+func (c *GeminiClient) RecrawlURIs(dataStoreName string, uris []string) (string, error) {
+	if len(uris) == 0 {
+		return "", fmt.Errorf("at least one URI is required")
+	}
+	operation, err := c.service.Projects.Locations.Collections.DataStores.SiteSearchEngine.
+		RecrawlUris(siteSearchEngineName(dataStoreName), &discoveryengine.GoogleCloudDiscoveryengineV1RecrawlUrisRequest{Uris: uris}).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to request recrawl: %w", err)
+	}
+	return operation.Name, nil
+}
+
+// GetRecrawlStatus returns the progress of a recrawl operation.
+func (c *GeminiClient) GetRecrawlStatus(operationName string) (*RecrawlStatus, error) {
+	status, err := c.GetOperation(operationName)
+	if err != nil {
+		return nil, err
+	}
+	return recrawlStatusFromOperation(status), nil
+}
+
+// WaitForRecrawl polls a recrawl operation until it finishes, calling onPoll with progress.
+func (c *GeminiClient) WaitForRecrawl(operationName string, interval, timeout time.Duration, onPoll func(*RecrawlStatus)) (*RecrawlStatus, error) {
+	status, err := c.WaitForOperation(operationName, interval, timeout, func(status *OperationStatus) {
+		if onPoll != nil {
+			onPoll(recrawlStatusFromOperation(status))
+		}
+	})
+	if status == nil {
+		return nil, err
+	}
+	return recrawlStatusFromOperation(status), err
+}
+
+// LoadTargetSitesFile reads target sites from a text file with one URI pattern
+// per line. Lines may add "exclude" and "exact" after the pattern; blank lines
+// and lines starting with # are ignored.
+func LoadTargetSitesFile(path string) ([]TargetSiteSpec, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	specs := []TargetSiteSpec{}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		spec := TargetSiteSpec{URIPattern: fields[0], Type: TargetSiteInclude}
+		for _, option := range fields[1:] {
+			switch strings.ToLower(option) {
+			case "include":
+				spec.Type = TargetSiteInclude
+			case "exclude":
+				spec.Type = TargetSiteExclude
+			case "exact":
+				spec.ExactMatch = true
+			default:
+				return nil, fmt.Errorf("%s:%d: unknown option %q (use include, exclude, or exact)", path, lineNumber, option)
+			}
+		}
+		specs = append(specs, spec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no target sites found in %s", path)
+	}
+	return specs, nil
+}
+
+func (c *GeminiClient) findTargetSite(dataStoreName, siteRef string) (*TargetSite, error) {
+	sites, err := c.ListTargetSites(dataStoreName)
+	if err != nil {
+		return nil, err
+	}
+	for _, site := range sites {
+		if site.ID == siteRef || site.Name == siteRef || site.URIPattern == siteRef {
+			return site, nil
+		}
+	}
+	return nil, fmt.Errorf("target site %q not found", siteRef)
+}
+
+func targetSiteRequest(spec TargetSiteSpec) (*discoveryengine.GoogleCloudDiscoveryengineV1TargetSite, error) {
+	pattern := strings.TrimSpace(spec.URIPattern)
+	if pattern == "" {
+		return nil, fmt.Errorf("target site URI pattern is required")
+	}
+
+	siteType := strings.ToUpper(strings.TrimSpace(spec.Type))
+	switch siteType {
+	case "":
+		siteType = TargetSiteInclude
+	case TargetSiteInclude, TargetSiteExclude:
+	default:
+		return nil, fmt.Errorf("invalid target site type %q (use include or exclude)", spec.Type)
+	}
+
+	return &discoveryengine.GoogleCloudDiscoveryengineV1TargetSite{
+		ProvidedUriPattern: pattern,
+		Type:               siteType,
+		ExactMatch:         spec.ExactMatch,
+	}, nil
+}
+
+func recrawlStatusFromOperation(status *OperationStatus) *RecrawlStatus {
+	result := &RecrawlStatus{Operation: status.Name, Done: status.Done, Error: status.Error}
+
+	var metadata discoveryengine.GoogleCloudDiscoveryengineV1alphaRecrawlUrisMetadata
+	if err := status.DecodeMetadata(&metadata); err == nil {
+		result.ValidURIs = metadata.ValidUrisCount
+		result.SuccessCount = metadata.SuccessCount
+		result.PendingCount = metadata.PendingCount
+		result.QuotaExceededCount = metadata.QuotaExceededCount
+		result.InvalidURIs = metadata.InvalidUris
+		result.NoindexURIs = metadata.NoindexUris
+		result.URIsNotMatchingTargetSites = metadata.UrisNotMatchingTargetSites
+	}
+
+	var response discoveryengine.GoogleCloudDiscoveryengineV1alphaRecrawlUrisResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.FailedURIs = response.FailedUris
+	}
+	return result
+}
+
+func convertTargetSite(site *discoveryengine.GoogleCloudDiscoveryengineV1TargetSite) *TargetSite {
+	result := &TargetSite{
+		Name:                site.Name,
+		ID:                  extractResourceID(site.Name),
+		URIPattern:          site.ProvidedUriPattern,
+		GeneratedURIPattern: site.GeneratedUriPattern,
+		RootDomainURI:       site.RootDomainUri,
+		Type:                site.Type,
+		ExactMatch:          site.ExactMatch,
+		IndexingStatus:      site.IndexingStatus,
+		UpdateTime:          site.UpdateTime,
+	}
+	if site.SiteVerificationInfo != nil {
+		result.VerificationState = site.SiteVerificationInfo.SiteVerificationState
+		result.VerifyTime = site.SiteVerificationInfo.VerifyTime
+	}
+	if site.FailureReason != nil && site.FailureReason.QuotaFailure != nil {
+		result.FailureReason = "quota exceeded"
+	}
+	return result
+}
+
+func siteSearchEngineName(dataStoreName string) string {
+	return strings.TrimSuffix(dataStoreName, "/") + "/siteSearchEngine"
+}
@@ -0,0 +1,240 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ValidationPass indicates a validation check succeeded.
+	ValidationPass = "PASS"
+	// ValidationWarn indicates a potential problem that does not block registration.
+	ValidationWarn = "WARN"
+	// ValidationFail indicates a problem that will break the agent at chat time.
+	ValidationFail = "FAIL"
+	// ValidationSkip indicates a check could not be evaluated.
+	ValidationSkip = "SKIP"
+
+	dialogflowClientRole        = "roles/dialogflow.client"
+	dialogflowAdminRole         = "roles/dialogflow.admin"
+	resourceManagerBaseURL      = "https://cloudresourcemanager.googleapis.com/v1"
+	discoveryEngineServiceAgent = "service-%s@gcp-sa-discoveryengine.iam.gserviceaccount.com"
+)
+
+// ValidationCheck is the result of a single validation step.
+type ValidationCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Fix     string `json:"fix,omitempty"`
+}
+
+// AgentValidationReport collects the checks run against a Dialogflow agent.
+type AgentValidationReport struct {
+	DialogflowAgent string            `json:"dialogflowAgent"`
+	DisplayName     string            `json:"displayName,omitempty"`
+	Checks          []ValidationCheck `json:"checks"`
+}
+
+// HasFailures reports whether any check failed.
+func (r *AgentValidationReport) HasFailures() bool {
+	if r == nil {
+		return false
+	}
+	for _, check := range r.Checks {
+		if check.Status == ValidationFail {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *AgentValidationReport) add(name, status, message, fix string) {
+	r.Checks = append(r.Checks, ValidationCheck{Name: name, Status: status, Message: message, Fix: fix})
+}
+
+// ValidateDialogflowAgent verifies that a Dialogflow CX agent exists, is in a
+// location compatible with the engine, and that the Discovery Engine service
+// agent is allowed to call it.
+func (c *GeminiClient) ValidateDialogflowAgent(resource string) (*AgentValidationReport, error) {
+	report := &AgentValidationReport{DialogflowAgent: resource}
+
+	dfProject, dfLocation, _, err := parseDialogflowAgentResource(resource)
+	if err != nil {
+		report.add("resource-format", ValidationFail, err.Error(),
+			"Use projects/PROJECT/locations/LOCATION/agents/AGENT_ID")
+		return report, nil
+	}
+	report.add("resource-format", ValidationPass, "Resource name is well formed", "")
+
+	if dialogflowLocationCompatible(c.config.Location, dfLocation) {
+		report.add("location", ValidationPass,
+			fmt.Sprintf("Dialogflow location %s is compatible with engine location %s", dfLocation, c.config.Location), "")
+	} else {
+		report.add("location", ValidationWarn,
+			fmt.Sprintf("Dialogflow location %s may not be reachable from engine location %s", dfLocation, c.config.Location),
+			fmt.Sprintf("Deploy the Dialogflow agent in a location within the %s multi-region", c.config.Location))
+	}
+
+	c.checkDialogflowAgentExists(report, resource, dfLocation)
+	c.checkCallerDialogflowPermissions(report, dfProject)
+	c.checkServiceAgentDialogflowRole(report, dfProject)
+
+	return report, nil
+}
+
+func (c *GeminiClient) checkDialogflowAgentExists(report *AgentValidationReport, resource, dfLocation string) {
+	body, err := c.doAPIRequest("dialogflow", http.MethodGet, dialogflowAgentURL(resource, dfLocation), nil)
+	switch code := httpStatusCode(err); {
+	case err == nil:
+		var agent struct {
+			DisplayName string `json:"displayName"`
+		}
+		_ = json.Unmarshal(body, &agent)
+		report.DisplayName = agent.DisplayName
+		report.add("agent-exists", ValidationPass, fmt.Sprintf("Found Dialogflow agent %q", agent.DisplayName), "")
+	case code == http.StatusNotFound:
+		report.add("agent-exists", ValidationFail, "Dialogflow CX agent was not found",
+			"Check the project, location, and agent ID in the Dialogflow CX console")
+	case code == http.StatusForbidden:
+		report.add("agent-exists", ValidationWarn, "Permission denied reading the Dialogflow CX agent; could not confirm it exists",
+			"Grant yourself roles/dialogflow.reader on the Dialogflow project or enable the Dialogflow API")
+	default:
+		report.add("agent-exists", ValidationSkip, fmt.Sprintf("Could not read Dialogflow agent: %v", err), "")
+	}
+}
+
+func (c *GeminiClient) checkCallerDialogflowPermissions(report *AgentValidationReport, dfProject string) {
+	permissions := []string{"dialogflow.agents.get", "dialogflow.sessions.detectIntent"}
+	granted, err := c.testProjectPermissions(dfProject, permissions)
+	if err != nil {
+		report.add("caller-permissions", ValidationSkip, fmt.Sprintf("Could not test IAM permissions: %v", err), "")
+		return
+	}
+
+	missing := []string{}
+	for _, permission := range permissions {
+		if !containsString(granted, permission) {
+			missing = append(missing, permission)
+		}
+	}
+	if len(missing) == 0 {
+		report.add("caller-permissions", ValidationPass, "Caller can read and query the Dialogflow agent", "")
+		return
+	}
+	report.add("caller-permissions", ValidationWarn,
+		fmt.Sprintf("Caller is missing %s on project %s", strings.Join(missing, ", "), dfProject),
+		fmt.Sprintf("Grant %s on project %s to test the agent yourself", dialogflowClientRole, dfProject))
+}
+
+func (c *GeminiClient) checkServiceAgentDialogflowRole(report *AgentValidationReport, dfProject string) {
+	projectNumber, err := c.projectNumber(c.config.ProjectID)
+	if err != nil {
+		report.add("service-agent-role", ValidationSkip, fmt.Sprintf("Could not resolve project number: %v", err), "")
+		return
+	}
+	member := "serviceAccount:" + fmt.Sprintf(discoveryEngineServiceAgent, projectNumber)
+	fix := fmt.Sprintf("gcloud projects add-iam-policy-binding %s --member=%s --role=%s", dfProject, member, dialogflowClientRole)
+
+	body, err := c.doAPIRequest("resourcemanager", http.MethodPost,
+		fmt.Sprintf("%s/projects/%s:getIamPolicy", resourceManagerBaseURL, dfProject), map[string]interface{}{})
+	if err != nil {
+		report.add("service-agent-role", ValidationSkip,
+			fmt.Sprintf("Could not read IAM policy of project %s: %v", dfProject, err),
+			"Ask a project owner to confirm the binding: "+fix)
+		return
+	}
+
+	var policy IamPolicy
+	if err := json.Unmarshal(body, &policy); err != nil {
+		report.add("service-agent-role", ValidationSkip, fmt.Sprintf("Could not decode IAM policy: %v", err), "")
+		return
+	}
+
+	for _, binding := range policy.Bindings {
+		if binding == nil || (binding.Role != dialogflowClientRole && binding.Role != dialogflowAdminRole) {
+			continue
+		}
+		if containsString(binding.Members, member) {
+			report.add("service-agent-role", ValidationPass,
+				fmt.Sprintf("%s has %s on %s", member, binding.Role, dfProject), "")
+			return
+		}
+	}
+	// The role may still be granted through a group or inherited from a folder
+	// or organization, which the project policy does not show.
+	report.add("service-agent-role", ValidationWarn,
+		fmt.Sprintf("No direct binding of %s for the Discovery Engine service agent on project %s; it may be granted through a group or inherited",
+			dialogflowClientRole, dfProject), "If the agent fails at chat time, run: "+fix)
+}
+
+func (c *GeminiClient) testProjectPermissions(projectID string, permissions []string) ([]string, error) {
+	body, err := c.doAPIRequest("resourcemanager", http.MethodPost,
+		fmt.Sprintf("%s/projects/%s:testIamPermissions", resourceManagerBaseURL, projectID),
+		map[string]interface{}{"permissions": permissions})
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		Permissions []string `json:"permissions"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode testIamPermissions response: %w", err)
+	}
+	return response.Permissions, nil
+}
+
+func (c *GeminiClient) projectNumber(projectID string) (string, error) {
+	body, err := c.doAPIRequest("resourcemanager", http.MethodGet,
+		fmt.Sprintf("%s/projects/%s", resourceManagerBaseURL, projectID), nil)
+	if err != nil {
+		return "", err
+	}
+	var project struct {
+		ProjectNumber string `json:"projectNumber"`
+	}
+	if err := json.Unmarshal(body, &project); err != nil {
+		return "", fmt.Errorf("failed to decode project: %w", err)
+	}
+	if project.ProjectNumber == "" {
+		return "", fmt.Errorf("project %s has no project number", projectID)
+	}
+	return project.ProjectNumber, nil
+}
+
+func parseDialogflowAgentResource(resource string) (project, location, agentID string, err error) {
+	parts := strings.Split(strings.TrimSpace(resource), "/")
+	if len(parts) != 6 || parts[0] != "projects" || parts[2] != "locations" || parts[4] != "agents" ||
+		parts[1] == "" || parts[3] == "" || parts[5] == "" {
+		return "", "", "", fmt.Errorf("dialogflow agent must be in the form projects/PROJECT/locations/LOCATION/agents/AGENT_ID")
+	}
+	return parts[1], parts[3], parts[5], nil
+}
+
+func dialogflowAgentURL(resource, location string) string {
+	if location == "global" {
+		return fmt.Sprintf("https://dialogflow.googleapis.com/v3/%s", resource)
+	}
+	return fmt.Sprintf("https://%s-dialogflow.googleapis.com/v3/%s", location, resource)
+}
+
+// dialogflowLocationCompatible reports whether a Dialogflow agent location can be
+// used from an engine location: global engines pair with global agents, and
+// multi-region engines (us, eu) pair with agents in the same geography.
+func dialogflowLocationCompatible(engineLocation, dialogflowLocation string) bool {
+	engineLocation = strings.ToLower(engineLocation)
+	dialogflowLocation = strings.ToLower(dialogflowLocation)
+
+	switch engineLocation {
+	case "global":
+		return dialogflowLocation == "global"
+	case "us":
+		return dialogflowLocation == "us" || strings.HasPrefix(dialogflowLocation, "us-")
+	case "eu":
+		return dialogflowLocation == "eu" || strings.HasPrefix(dialogflowLocation, "europe-")
+	default:
+		return engineLocation == dialogflowLocation
+	}
+}
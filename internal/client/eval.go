@@ -0,0 +1,401 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/discoveryengine/v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultEvalK           = 10
+	maxEvalK               = 100
+	defaultEvalConcurrency = 4
+
+	// evalEpsilon is the smallest nDCG change counted as an improvement or regression.
+	evalEpsilon = 1e-9
+)
+
+// EvalQuery is a sample query with the documents a good result list contains.
+// Expected entries match a result by document ID, resource name, or URI.
+type EvalQuery struct {
+	Query    string   `json:"query" yaml:"query"`
+	Expected []string `json:"expected" yaml:"expected"`
+}
+
+// EvalQuerySet is a list of sample queries used for offline evaluation.
+type EvalQuerySet struct {
+	Name    string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Queries []EvalQuery `json:"queries" yaml:"queries"`
+}
+
+// EvalMetrics holds the ranking metrics of one query or the mean over a set.
+type EvalMetrics struct {
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	MRR       float64 `json:"mrr"`
+	NDCG      float64 `json:"ndcg"`
+}
+
+// EvalQueryResult is the outcome of one sample query.
+type EvalQueryResult struct {
+	Query     string      `json:"query"`
+	Expected  []string    `json:"expected"`
+	Retrieved []string    `json:"retrieved"`
+	Matched   []string    `json:"matched,omitempty"`
+	Metrics   EvalMetrics `json:"metrics"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// EvalReport summarizes an evaluation run against one serving config.
+type EvalReport struct {
+	ServingConfig string            `json:"servingConfig"`
+	K             int               `json:"k"`
+	QueryCount    int               `json:"queryCount"`
+	ErrorCount    int               `json:"errorCount,omitempty"`
+	Mean          EvalMetrics       `json:"mean"`
+	Queries       []EvalQueryResult `json:"queries"`
+}
+
+// EvalQueryComparison compares one query across two runs.
+type EvalQueryComparison struct {
+	Query     string      `json:"query"`
+	Baseline  EvalMetrics `json:"baseline"`
+	Candidate EvalMetrics `json:"candidate"`
+	Delta     EvalMetrics `json:"delta"`
+}
+
+// EvalComparison is the side-by-side diff of two evaluation runs over the
+// same query set. Queries are ordered from largest nDCG regression to
+// largest improvement.
+type EvalComparison struct {
+	K         int                   `json:"k"`
+	Baseline  *EvalReport           `json:"baseline"`
+	Candidate *EvalReport           `json:"candidate"`
+	Delta     EvalMetrics           `json:"delta"`
+	Improved  int                   `json:"improved"`
+	Regressed int                   `json:"regressed"`
+	Unchanged int                   `json:"unchanged"`
+	Queries   []EvalQueryComparison `json:"queries"`
+}
+
+// SearchHit is a single search result used for evaluation.
+type SearchHit struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	URI   string `json:"uri,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// LoadEvalQuerySet reads sample queries from a YAML or JSON file with a
+// queries list, or from a .jsonl file with one query object per line.
+func LoadEvalQuerySet(path string) (*EvalQuerySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query set %s: %w", path, err)
+	}
+
+	set := &EvalQuerySet{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		for i, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			var query EvalQuery
+			if err := json.Unmarshal([]byte(line), &query); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid query: %w", path, i+1, err)
+			}
+			set.Queries = append(set.Queries, query)
+		}
+	default:
+		if err := yaml.Unmarshal(data, set); err != nil {
+			var queries []EvalQuery
+			if listErr := yaml.Unmarshal(data, &queries); listErr != nil {
+				return nil, fmt.Errorf("failed to parse query set %s: %w", path, err)
+			}
+			set.Queries = queries
+		}
+	}
+
+	if set.Name == "" {
+		set.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if err := set.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return set, nil
+}
+
+// Validate checks that every query has text and at least one expected document.
+func (s *EvalQuerySet) Validate() error {
+	if len(s.Queries) == 0 {
+		return fmt.Errorf("query set has no queries")
+	}
+	for i, query := range s.Queries {
+		if strings.TrimSpace(query.Query) == "" {
+			return fmt.Errorf("query %d: query text is required", i+1)
+		}
+		if len(query.Expected) == 0 {
+			return fmt.Errorf("query %q: at least one expected document is required", query.Query)
+		}
+	}
+	return nil
+}
+
+// SearchServingConfig runs a query through a serving config and returns the
+// top pageSize documents.
+func (c *GeminiClient) SearchServingConfig(servingConfigName, query string, pageSize int) ([]SearchHit, error) {
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1SearchRequest{
+		Query:    query,
+		PageSize: int64(pageSize),
+	}
+	response, err := c.service.Projects.Locations.Collections.Engines.ServingConfigs.Search(servingConfigName, request).Do()
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	hits := []SearchHit{}
+	for _, result := range response.Results {
+		if result.Document == nil {
+			hits = append(hits, SearchHit{ID: result.Id})
+			continue
+		}
+		hits = append(hits, convertSearchHit(result.Document))
+	}
+	return hits, nil
+}
+
+// RunEvaluation searches every query of the set against a serving config and
+// computes precision@k, recall@k, MRR, and nDCG@k. Queries that fail are
+// reported with their error and left out of the mean.
+func (c *GeminiClient) RunEvaluation(servingConfigName string, set *EvalQuerySet, k, concurrency int) *EvalReport {
+	if k <= 0 {
+		k = defaultEvalK
+	}
+	if k > maxEvalK {
+		k = maxEvalK
+	}
+	if concurrency <= 0 {
+		concurrency = defaultEvalConcurrency
+	}
+
+	results := make([]EvalQueryResult, len(set.Queries))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, query := range set.Queries {
+		wg.Add(1)
+		go func(i int, query EvalQuery) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := EvalQueryResult{Query: query.Query, Expected: query.Expected, Retrieved: []string{}}
+			hits, err := c.SearchServingConfig(servingConfigName, query.Query, k)
+			if err != nil {
+				result.Error = err.Error()
+				results[i] = result
+				return
+			}
+			for _, hit := range hits {
+				result.Retrieved = append(result.Retrieved, hit.Ref())
+			}
+			result.Metrics, result.Matched = ComputeEvalMetrics(hits, query.Expected, k)
+			results[i] = result
+		}(i, query)
+	}
+	wg.Wait()
+
+	return newEvalReport(servingConfigName, k, results)
+}
+
+// CompareEvalReports diffs two runs of the same query set.
+func CompareEvalReports(baseline, candidate *EvalReport) *EvalComparison {
+	comparison := &EvalComparison{
+		K:         baseline.K,
+		Baseline:  baseline,
+		Candidate: candidate,
+		Delta:     candidate.Mean.Sub(baseline.Mean),
+		Queries:   []EvalQueryComparison{},
+	}
+
+	candidateByQuery := make(map[string]EvalQueryResult, len(candidate.Queries))
+	for _, result := range candidate.Queries {
+		candidateByQuery[result.Query] = result
+	}
+
+	for _, base := range baseline.Queries {
+		other, ok := candidateByQuery[base.Query]
+		if !ok || base.Error != "" || other.Error != "" {
+			continue
+		}
+		entry := EvalQueryComparison{
+			Query:     base.Query,
+			Baseline:  base.Metrics,
+			Candidate: other.Metrics,
+			Delta:     other.Metrics.Sub(base.Metrics),
+		}
+		switch {
+		case entry.Delta.NDCG > evalEpsilon:
+			comparison.Improved++
+		case entry.Delta.NDCG < -evalEpsilon:
+			comparison.Regressed++
+		default:
+			comparison.Unchanged++
+		}
+		comparison.Queries = append(comparison.Queries, entry)
+	}
+
+	sort.SliceStable(comparison.Queries, func(i, j int) bool {
+		return comparison.Queries[i].Delta.NDCG < comparison.Queries[j].Delta.NDCG
+	})
+	return comparison
+}
+
+// ComputeEvalMetrics scores the top k hits against the expected documents
+// with binary relevance. It returns the metrics and the expected entries that
+// were found.
+func ComputeEvalMetrics(hits []SearchHit, expected []string, k int) (EvalMetrics, []string) {
+	metrics := EvalMetrics{}
+	if len(expected) == 0 || k <= 0 {
+		return metrics, nil
+	}
+	if len(hits) > k {
+		hits = hits[:k]
+	}
+
+	found := make(map[int]bool, len(expected))
+	matched := []string{}
+	dcg := 0.0
+	for rank, hit := range hits {
+		index := hit.matchExpected(expected, found)
+		if index < 0 {
+			continue
+		}
+		found[index] = true
+		matched = append(matched, expected[index])
+		dcg += 1 / math.Log2(float64(rank+2))
+		if metrics.MRR == 0 {
+			metrics.MRR = 1 / float64(rank+1)
+		}
+	}
+
+	idealCount := len(expected)
+	if idealCount > k {
+		idealCount = k
+	}
+	idcg := 0.0
+	for rank := 0; rank < idealCount; rank++ {
+		idcg += 1 / math.Log2(float64(rank+2))
+	}
+
+	metrics.Precision = float64(len(matched)) / float64(k)
+	metrics.Recall = float64(len(matched)) / float64(len(expected))
+	if idcg > 0 {
+		metrics.NDCG = dcg / idcg
+	}
+	return metrics, matched
+}
+
+// Sub returns m - other.
+func (m EvalMetrics) Sub(other EvalMetrics) EvalMetrics {
+	return EvalMetrics{
+		Precision: m.Precision - other.Precision,
+		Recall:    m.Recall - other.Recall,
+		MRR:       m.MRR - other.MRR,
+		NDCG:      m.NDCG - other.NDCG,
+	}
+}
+
+// Ref returns the most readable identifier of the hit.
+func (h SearchHit) Ref() string {
+	if h.URI != "" {
+		return h.URI
+	}
+	return h.ID
+}
+
+// matchExpected returns the index of the first expected entry not yet found
+// that refers to this hit, or -1.
+func (h SearchHit) matchExpected(expected []string, found map[int]bool) int {
+	for i, ref := range expected {
+		if found[i] {
+			continue
+		}
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		if ref == h.ID || ref == h.Name || (h.URI != "" && normalizeEvalURI(ref) == normalizeEvalURI(h.URI)) {
+			return i
+		}
+	}
+	return -1
+}
+
+func normalizeEvalURI(uri string) string {
+	return strings.TrimRight(strings.TrimSpace(uri), "/")
+}
+
+func newEvalReport(servingConfigName string, k int, results []EvalQueryResult) *EvalReport {
+	report := &EvalReport{
+		ServingConfig: servingConfigName,
+		K:             k,
+		QueryCount:    len(results),
+		Queries:       results,
+	}
+
+	scored := 0
+	for _, result := range results {
+		if result.Error != "" {
+			report.ErrorCount++
+			continue
+		}
+		scored++
+		report.Mean.Precision += result.Metrics.Precision
+		report.Mean.Recall += result.Metrics.Recall
+		report.Mean.MRR += result.Metrics.MRR
+		report.Mean.NDCG += result.Metrics.NDCG
+	}
+	if scored > 0 {
+		report.Mean.Precision /= float64(scored)
+		report.Mean.Recall /= float64(scored)
+		report.Mean.MRR /= float64(scored)
+		report.Mean.NDCG /= float64(scored)
+	}
+	return report
+}
+
+func convertSearchHit(document *discoveryengine.GoogleCloudDiscoveryengineV1Document) SearchHit {
+	hit := SearchHit{ID: document.Id, Name: document.Name}
+	for _, raw := range [][]byte{document.DerivedStructData, document.StructData} {
+		if len(raw) == 0 {
+			continue
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			continue
+		}
+		for _, key := range []string{"link", "uri", "url"} {
+			if value, ok := fields[key].(string); ok && hit.URI == "" {
+				hit.URI = value
+			}
+		}
+		if value, ok := fields["title"].(string); ok && hit.Title == "" {
+			hit.Title = value
+		}
+	}
+	if hit.URI == "" && document.Content != nil {
+		hit.URI = document.Content.Uri
+	}
+	return hit
+}
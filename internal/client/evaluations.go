@@ -0,0 +1,259 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Evaluation states reported by the evaluations API.
+const (
+	EvaluationStateSucceeded = "SUCCEEDED"
+	EvaluationStateFailed    = "FAILED"
+)
+
+// SampleQuerySet is a platform-managed set of sample queries.
+type SampleQuerySet struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	CreateTime  string `json:"createTime,omitempty"`
+}
+
+// SampleQuery is a query with its expected targets in a sample query set.
+type SampleQuery struct {
+	Name       string `json:"name"`
+	QueryEntry struct {
+		Query   string `json:"query"`
+		Targets []struct {
+			URI         string  `json:"uri"`
+			PageNumbers []int   `json:"pageNumbers,omitempty"`
+			Score       float64 `json:"score,omitempty"`
+		} `json:"targets"`
+	} `json:"queryEntry"`
+}
+
+// EvaluationTopK holds a quality metric at cutoffs 1, 3, 5, and 10.
+type EvaluationTopK struct {
+	Top1  float64 `json:"top1"`
+	Top3  float64 `json:"top3"`
+	Top5  float64 `json:"top5"`
+	Top10 float64 `json:"top10"`
+}
+
+// EvaluationQualityMetrics are the aggregate metrics computed by a platform evaluation.
+type EvaluationQualityMetrics struct {
+	DocRecall    *EvaluationTopK `json:"docRecall,omitempty"`
+	DocPrecision *EvaluationTopK `json:"docPrecision,omitempty"`
+	DocNdcg      *EvaluationTopK `json:"docNdcg,omitempty"`
+	PageRecall   *EvaluationTopK `json:"pageRecall,omitempty"`
+	PageNdcg     *EvaluationTopK `json:"pageNdcg,omitempty"`
+}
+
+// Evaluation is a platform-run search quality evaluation.
+type Evaluation struct {
+	Name           string                    `json:"name"`
+	State          string                    `json:"state,omitempty"`
+	CreateTime     string                    `json:"createTime,omitempty"`
+	EndTime        string                    `json:"endTime,omitempty"`
+	QualityMetrics *EvaluationQualityMetrics `json:"qualityMetrics,omitempty"`
+	Error          *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+	EvaluationSpec struct {
+		SearchRequest struct {
+			ServingConfig string `json:"servingConfig"`
+		} `json:"searchRequest"`
+		QuerySetSpec struct {
+			SampleQuerySet string `json:"sampleQuerySet"`
+		} `json:"querySetSpec"`
+	} `json:"evaluationSpec"`
+}
+
+// ListSampleQuerySets lists the sample query sets of the project location.
+func (c *GeminiClient) ListSampleQuerySets() ([]*SampleQuerySet, error) {
+	collectionURL := c.resourceURL(c.locationName()) + "/sampleQuerySets"
+
+	sets := []*SampleQuerySet{}
+	pageToken := ""
+	for {
+		pageURL := collectionURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("sample query set", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			SampleQuerySets []*SampleQuerySet `json:"sampleQuerySets"`
+			NextPageToken   string            `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode sample query sets response: %w", err)
+		}
+		sets = append(sets, response.SampleQuerySets...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Slice(sets, func(i, j int) bool {
+		return sets[i].Name < sets[j].Name
+	})
+	return sets, nil
+}
+
+// LoadSampleQuerySet reads the queries of a platform sample query set as an
+// evaluation query set. Target URIs become the expected documents.
+func (c *GeminiClient) LoadSampleQuerySet(sampleQuerySetName string) (*EvalQuerySet, error) {
+	collectionURL := c.resourceURL(sampleQuerySetName) + "/sampleQueries"
+
+	set := &EvalQuerySet{Name: extractResourceID(sampleQuerySetName)}
+	pageToken := ""
+	for {
+		pageURL := collectionURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("sample query", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			SampleQueries []*SampleQuery `json:"sampleQueries"`
+			NextPageToken string         `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode sample queries response: %w", err)
+		}
+		for _, sample := range response.SampleQueries {
+			query := EvalQuery{Query: sample.QueryEntry.Query}
+			for _, target := range sample.QueryEntry.Targets {
+				query.Expected = append(query.Expected, target.URI)
+			}
+			set.Queries = append(set.Queries, query)
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	if err := set.Validate(); err != nil {
+		return nil, fmt.Errorf("sample query set %s: %w", sampleQuerySetName, err)
+	}
+	return set, nil
+}
+
+// CreateEvaluation starts a platform evaluation of a serving config against a
+// sample query set and returns the evaluation once it has been created.
+func (c *GeminiClient) CreateEvaluation(servingConfigName, sampleQuerySetName string) (*Evaluation, error) {
+	payload := map[string]interface{}{
+		"evaluationSpec": map[string]interface{}{
+			"searchRequest": map[string]interface{}{"servingConfig": servingConfigName},
+			"querySetSpec":  map[string]interface{}{"sampleQuerySet": sampleQuerySetName},
+		},
+	}
+
+	body, err := c.doAPIRequest("evaluation", http.MethodPost, c.resourceURL(c.locationName())+"/evaluations", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var operation struct {
+		Name     string      `json:"name"`
+		Done     bool        `json:"done"`
+		Response *Evaluation `json:"response"`
+	}
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return nil, fmt.Errorf("failed to decode evaluation operation: %w", err)
+	}
+	if operation.Done && operation.Response != nil {
+		return operation.Response, nil
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	var evaluation Evaluation
+	if err := status.DecodeResponse(&evaluation); err != nil || evaluation.Name == "" {
+		return nil, fmt.Errorf("evaluation operation %s returned no evaluation", operation.Name)
+	}
+	return &evaluation, nil
+}
+
+// GetEvaluation retrieves a platform evaluation by resource name.
+func (c *GeminiClient) GetEvaluation(evaluationName string) (*Evaluation, error) {
+	body, err := c.doAPIRequest("evaluation", http.MethodGet, c.resourceURL(evaluationName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var evaluation Evaluation
+	if err := json.Unmarshal(body, &evaluation); err != nil {
+		return nil, fmt.Errorf("failed to decode evaluation: %w", err)
+	}
+	return &evaluation, nil
+}
+
+// WaitForEvaluation polls an evaluation until it succeeds or fails. onPoll,
+// when set, is called with each intermediate state.
+func (c *GeminiClient) WaitForEvaluation(evaluationName string, interval, timeout time.Duration, onPoll func(*Evaluation)) (*Evaluation, error) {
+	if interval <= 0 {
+		interval = defaultOperationPollInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultOperationTimeout
+	}
+
+	startTime := time.Now()
+	for {
+		evaluation, err := c.GetEvaluation(evaluationName)
+		if err != nil {
+			return nil, err
+		}
+		switch evaluation.State {
+		case EvaluationStateSucceeded:
+			return evaluation, nil
+		case EvaluationStateFailed:
+			message := "unknown error"
+			if evaluation.Error != nil && evaluation.Error.Message != "" {
+				message = evaluation.Error.Message
+			}
+			return evaluation, fmt.Errorf("evaluation %s failed: %s", evaluationName, message)
+		}
+		if onPoll != nil {
+			onPoll(evaluation)
+		}
+		if time.Since(startTime) >= timeout {
+			return evaluation, fmt.Errorf("timeout waiting for evaluation %s", evaluationName)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// ConstructSampleQuerySetName builds a sample query set resource name from an ID.
+// Full resource names are returned unchanged.
+func (c *GeminiClient) ConstructSampleQuerySetName(sampleQuerySetID string) string {
+	if strings.HasPrefix(sampleQuerySetID, "projects/") {
+		return sampleQuerySetID
+	}
+	return fmt.Sprintf("%s/sampleQuerySets/%s", c.locationName(), sampleQuerySetID)
+}
+
+func (c *GeminiClient) locationName() string {
+	return fmt.Sprintf("projects/%s/locations/%s", c.config.ProjectID, c.config.Location)
+}
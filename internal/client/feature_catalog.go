@@ -0,0 +1,197 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FeatureCatalogVersion identifies the revision of the built-in feature catalog.
+// Bump it whenever features are added, removed, or their rules change.
+const FeatureCatalogVersion = "2025-10"
+
+// FeatureWildcard is the catch-all feature key accepted by the engine API.
+const FeatureWildcard = "*"
+
+// FeatureDefinition describes an engine feature flag known to gemctl.
+type FeatureDefinition struct {
+	Key          string   `json:"key" yaml:"key"`
+	Description  string   `json:"description" yaml:"description"`
+	DefaultState string   `json:"defaultState,omitempty" yaml:"defaultState,omitempty"`
+	Inverse      string   `json:"inverse,omitempty" yaml:"inverse,omitempty"`
+	Requires     []string `json:"requires,omitempty" yaml:"requires,omitempty"`
+}
+
+// FeatureConflict describes a rule violated by a combination of feature states.
+type FeatureConflict struct {
+	Feature string `json:"feature"`
+	Other   string `json:"other"`
+	Message string `json:"message"`
+}
+
+var featureCatalog = []FeatureDefinition{
+	{Key: FeatureWildcard, Description: "Applies the state to every feature not set explicitly"},
+	{Key: "agent-gallery", Description: "Show the agent gallery so users can discover agents", DefaultState: EngineFeatureStateOn},
+	{Key: "no-code-agent-builder", Description: "Allow users to build agents without code", DefaultState: EngineFeatureStateOn},
+	{Key: "prompt-gallery", Description: "Show the gallery of suggested prompts", DefaultState: EngineFeatureStateOn},
+	{Key: "model-selector", Description: "Let users choose the model used for answers", DefaultState: EngineFeatureStateOn},
+	{Key: "notebook-lm", Description: "Enable NotebookLM integration", DefaultState: EngineFeatureStateOn},
+	{Key: "people-search", Description: "Search people in the connected directory", DefaultState: EngineFeatureStateOn},
+	{Key: "people-search-org-chart", Description: "Show org charts in people search results", DefaultState: EngineFeatureStateOn,
+		Requires: []string{"people-search"}},
+	{Key: "bi-directional-audio", Description: "Enable live voice conversations with the assistant", DefaultState: EngineFeatureStateOff},
+	{Key: "feedback", Description: "Let users send thumbs up/down feedback on answers", DefaultState: EngineFeatureStateOn},
+	{Key: "session-sharing", Description: "Allow users to share chat sessions with others", DefaultState: EngineFeatureStateOff},
+	{Key: "personalization-memory", Description: "Remember user preferences across sessions", DefaultState: EngineFeatureStateOff},
+	{Key: "disable-agent-sharing", Description: "Prevent users from sharing the agents they build", DefaultState: EngineFeatureStateOff,
+		Inverse: "agent-sharing-without-admin-approval"},
+	{Key: "agent-sharing-without-admin-approval", Description: "Let users share agents without admin approval", DefaultState: EngineFeatureStateOff,
+		Inverse: "disable-agent-sharing"},
+	{Key: "disable-image-generation", Description: "Turn off image generation in answers", DefaultState: EngineFeatureStateOff},
+	{Key: "disable-video-generation", Description: "Turn off video generation in answers", DefaultState: EngineFeatureStateOff},
+	{Key: "disable-onedrive-upload", Description: "Block file uploads from OneDrive", DefaultState: EngineFeatureStateOff},
+	{Key: "disable-talk-to-content", Description: "Turn off chatting with uploaded content", DefaultState: EngineFeatureStateOff},
+	{Key: "disable-google-drive-upload", Description: "Block file uploads from Google Drive", DefaultState: EngineFeatureStateOff},
+}
+
+var featureCatalogIndex = func() map[string]*FeatureDefinition {
+	index := make(map[string]*FeatureDefinition, len(featureCatalog))
+	for i := range featureCatalog {
+		index[featureCatalog[i].Key] = &featureCatalog[i]
+	}
+	return index
+}()
+
+// FeatureCatalog returns the known feature definitions sorted by key.
+func FeatureCatalog() []FeatureDefinition {
+	definitions := append([]FeatureDefinition{}, featureCatalog...)
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Key < definitions[j].Key
+	})
+	return definitions
+}
+
+// LookupFeature returns the catalog definition for key.
+func LookupFeature(key string) (*FeatureDefinition, bool) {
+	definition, ok := featureCatalogIndex[strings.ToLower(strings.TrimSpace(key))]
+	return definition, ok
+}
+
+// SuggestFeatures returns catalog keys close to an unknown key, best match first.
+func SuggestFeatures(key string) []string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if key == "" {
+		return nil
+	}
+
+	type candidate struct {
+		key      string
+		distance int
+	}
+	candidates := []candidate{}
+	for _, definition := range featureCatalog {
+		if definition.Key == FeatureWildcard {
+			continue
+		}
+		distance := levenshtein(key, definition.Key)
+		threshold := len(definition.Key) / 3
+		if threshold < 2 {
+			threshold = 2
+		}
+		if distance <= threshold || strings.Contains(definition.Key, key) {
+			candidates = append(candidates, candidate{key: definition.Key, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].key < candidates[j].key
+	})
+
+	suggestions := []string{}
+	for i, c := range candidates {
+		if i == 3 {
+			break
+		}
+		suggestions = append(suggestions, c.key)
+	}
+	return suggestions
+}
+
+// ValidateFeatureKey returns an error with "did you mean" suggestions when key
+// is not in the catalog.
+func ValidateFeatureKey(key string) error {
+	if _, ok := LookupFeature(key); ok {
+		return nil
+	}
+	suggestions := SuggestFeatures(key)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("unknown feature %q (catalog %s)", key, FeatureCatalogVersion)
+	}
+	return fmt.Errorf("unknown feature %q; did you mean %s?", key, strings.Join(suggestions, ", "))
+}
+
+// CheckFeatureConflicts reports inverse and dependency rules violated by the
+// given feature states. Features missing from states fall back to the wildcard
+// state, then to their catalog default.
+func CheckFeatureConflicts(states map[string]string) []FeatureConflict {
+	isOn := func(key string) bool {
+		return effectiveFeatureState(states, key) == EngineFeatureStateOn
+	}
+
+	conflicts := []FeatureConflict{}
+	seen := map[string]struct{}{}
+	addPair := func(a, b, message string) {
+		pair := a + "|" + b
+		if a > b {
+			pair = b + "|" + a
+		}
+		if _, ok := seen[pair]; ok {
+			return
+		}
+		seen[pair] = struct{}{}
+		conflicts = append(conflicts, FeatureConflict{Feature: a, Other: b, Message: message})
+	}
+
+	for _, definition := range FeatureCatalog() {
+		if !isOn(definition.Key) {
+			continue
+		}
+		if definition.Inverse != "" && isOn(definition.Inverse) {
+			addPair(definition.Key, definition.Inverse,
+				fmt.Sprintf("%s and %s are inverses and should not both be ON", definition.Key, definition.Inverse))
+		}
+		for _, required := range definition.Requires {
+			if !isOn(required) {
+				conflicts = append(conflicts, FeatureConflict{
+					Feature: definition.Key,
+					Other:   required,
+					Message: fmt.Sprintf("%s has no effect unless %s is ON", definition.Key, required),
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
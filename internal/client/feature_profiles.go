@@ -0,0 +1,200 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FeatureProfile is a named set of feature states applied across engines.
+type FeatureProfile struct {
+	Name        string            `json:"name" yaml:"-"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Features    map[string]string `json:"features" yaml:"features"`
+}
+
+// FeatureProfileSet holds feature profiles and the profile assigned to each engine.
+type FeatureProfileSet struct {
+	Profiles    map[string]*FeatureProfile `json:"profiles" yaml:"profiles"`
+	Assignments map[string]string          `json:"assignments,omitempty" yaml:"assignments,omitempty"`
+}
+
+// FeatureComplianceEntry reports how an engine's features compare with its profile.
+type FeatureComplianceEntry struct {
+	Engine     string        `json:"engine"`
+	Profile    string        `json:"profile"`
+	Compliant  bool          `json:"compliant"`
+	Deviations []FeatureDiff `json:"deviations,omitempty"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// FeatureComplianceReport summarizes profile compliance across engines.
+type FeatureComplianceReport struct {
+	GeneratedAt    string                   `json:"generatedAt"`
+	CatalogVersion string                   `json:"catalogVersion"`
+	Entries        []FeatureComplianceEntry `json:"entries"`
+}
+
+// DeviatingEngines returns the number of engines that are not compliant.
+func (r *FeatureComplianceReport) DeviatingEngines() int {
+	count := 0
+	for _, entry := range r.Entries {
+		if !entry.Compliant {
+			count++
+		}
+	}
+	return count
+}
+
+// BuiltinFeatureProfiles returns the profiles shipped with gemctl.
+func BuiltinFeatureProfiles() map[string]*FeatureProfile {
+	return map[string]*FeatureProfile{
+		"locked-down": {
+			Name:        "locked-down",
+			Description: "Disable media generation, file uploads, and agent sharing",
+			Features: map[string]string{
+				"disable-image-generation":             EngineFeatureStateOn,
+				"disable-video-generation":             EngineFeatureStateOn,
+				"disable-onedrive-upload":              EngineFeatureStateOn,
+				"disable-google-drive-upload":          EngineFeatureStateOn,
+				"disable-agent-sharing":                EngineFeatureStateOn,
+				"agent-sharing-without-admin-approval": EngineFeatureStateOff,
+			},
+		},
+	}
+}
+
+// LoadFeatureProfiles reads feature profiles from a YAML file and merges them
+// over the built-in profiles. An empty path returns only the built-in profiles.
+func LoadFeatureProfiles(path string) (*FeatureProfileSet, error) {
+	set := &FeatureProfileSet{Profiles: BuiltinFeatureProfiles()}
+	if path == "" {
+		return set, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feature profiles %s: %w", path, err)
+	}
+
+	var fileSet FeatureProfileSet
+	if err := yaml.Unmarshal(data, &fileSet); err != nil {
+		return nil, fmt.Errorf("failed to parse feature profiles: %w", err)
+	}
+
+	for name, profile := range fileSet.Profiles {
+		if profile == nil {
+			continue
+		}
+		profile.Name = name
+		normalized, err := normalizeProfileFeatures(profile.Features)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+		profile.Features = normalized
+		set.Profiles[name] = profile
+	}
+
+	set.Assignments = fileSet.Assignments
+	for engineID, profileName := range set.Assignments {
+		if _, ok := set.Profiles[profileName]; !ok {
+			return nil, fmt.Errorf("engine %s is assigned unknown profile %q", engineID, profileName)
+		}
+	}
+
+	return set, nil
+}
+
+// Profile returns the named profile or an error listing the available ones.
+func (s *FeatureProfileSet) Profile(name string) (*FeatureProfile, error) {
+	if profile, ok := s.Profiles[name]; ok {
+		return profile, nil
+	}
+	return nil, fmt.Errorf("unknown feature profile %q (available: %s)", name, strings.Join(s.ProfileNames(), ", "))
+}
+
+// ProfileNames returns the profile names in sorted order.
+func (s *FeatureProfileSet) ProfileNames() []string {
+	names := make([]string, 0, len(s.Profiles))
+	for name := range s.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PlanFeatureProfile returns the feature changes needed for an engine to match
+// profile. It compares effective states, like FeatureProfileDeviations, so an
+// engine reported as compliant has an empty plan.
+func PlanFeatureProfile(engine *Engine, profile *FeatureProfile) []FeatureDiff {
+	return FeatureProfileDeviations(engine, profile)
+}
+
+// FeatureProfileDeviations reports profile features whose effective state on
+// the engine differs from the profile. Unset features use the wildcard state,
+// then their catalog default.
+func FeatureProfileDeviations(engine *Engine, profile *FeatureProfile) []FeatureDiff {
+	current := existingEngineFeatures(engine)
+
+	keys := make([]string, 0, len(profile.Features))
+	for key := range profile.Features {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	deviations := []FeatureDiff{}
+	for _, key := range keys {
+		effective := effectiveFeatureState(current, key)
+		if effective != profile.Features[key] {
+			deviations = append(deviations, FeatureDiff{Feature: key, Old: effective, New: profile.Features[key]})
+		}
+	}
+	return deviations
+}
+
+// ParseFeatureState converts on/off style values into API feature states.
+func ParseFeatureState(value string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "ON", "TRUE", "ENABLED", EngineFeatureStateOn:
+		return EngineFeatureStateOn, nil
+	case "OFF", "FALSE", "DISABLED", EngineFeatureStateOff:
+		return EngineFeatureStateOff, nil
+	default:
+		return "", fmt.Errorf("invalid feature state %q (use on or off)", value)
+	}
+}
+
+func normalizeProfileFeatures(features map[string]string) (map[string]string, error) {
+	if len(features) == 0 {
+		return nil, fmt.Errorf("no features defined")
+	}
+	normalized := make(map[string]string, len(features))
+	for key, value := range features {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if err := ValidateFeatureKey(key); err != nil {
+			return nil, err
+		}
+		state, err := ParseFeatureState(value)
+		if err != nil {
+			return nil, fmt.Errorf("feature %s: %w", key, err)
+		}
+		normalized[key] = state
+	}
+	return normalized, nil
+}
+
+func effectiveFeatureState(features map[string]string, key string) string {
+	if state, ok := features[key]; ok {
+		return state
+	}
+	if state, ok := features[FeatureWildcard]; ok {
+		return state
+	}
+	if definition, ok := LookupFeature(key); ok && definition.DefaultState != "" {
+		return definition.DefaultState
+	}
+	return EngineFeatureStateOff
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/discoveryengine/v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultGroundingConfigID is the grounding config every project location provides.
+	DefaultGroundingConfigID = "default_grounding_config"
+	// DefaultCitationThreshold is the service default for citing a fact.
+	DefaultCitationThreshold = 0.6
+)
+
+// GroundingFact is a piece of source text an answer is checked against.
+type GroundingFact struct {
+	FactText   string            `json:"factText" yaml:"factText"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+// GroundingCheckOptions tunes a grounding check.
+type GroundingCheckOptions struct {
+	CitationThreshold float64
+	ClaimLevelScores  bool
+}
+
+// GroundingClaim is a sentence of the answer with the facts that support it.
+type GroundingClaim struct {
+	Text                   string  `json:"text" yaml:"text"`
+	StartPos               int64   `json:"startPos" yaml:"startPos"`
+	EndPos                 int64   `json:"endPos" yaml:"endPos"`
+	Score                  float64 `json:"score,omitempty" yaml:"score,omitempty"`
+	Citations              []int64 `json:"citations,omitempty" yaml:"citations,omitempty"`
+	GroundingCheckRequired bool    `json:"groundingCheckRequired" yaml:"groundingCheckRequired"`
+}
+
+// GroundingCitedChunk is a fact cited by at least one claim. Claim citations
+// refer to Index; Source is the index of the fact in the request.
+type GroundingCitedChunk struct {
+	Index      int64             `json:"index" yaml:"index"`
+	Text       string            `json:"text" yaml:"text"`
+	Source     string            `json:"source,omitempty" yaml:"source,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+// GroundingCheckResult is the support score and claim-level citations of an answer.
+type GroundingCheckResult struct {
+	SupportScore float64               `json:"supportScore" yaml:"supportScore"`
+	Claims       []GroundingClaim      `json:"claims" yaml:"claims"`
+	CitedChunks  []GroundingCitedChunk `json:"citedChunks,omitempty" yaml:"citedChunks,omitempty"`
+}
+
+// CheckGrounding scores how well the facts support an answer candidate and
+// returns the citations of each claim.
+func (c *GeminiClient) CheckGrounding(answer string, facts []GroundingFact, opts GroundingCheckOptions) (*GroundingCheckResult, error) {
+	if strings.TrimSpace(answer) == "" {
+		return nil, fmt.Errorf("answer candidate is empty")
+	}
+	if len(facts) == 0 {
+		return nil, fmt.Errorf("at least one fact is required")
+	}
+
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1CheckGroundingRequest{
+		AnswerCandidate: answer,
+		GroundingSpec: &discoveryengine.GoogleCloudDiscoveryengineV1CheckGroundingSpec{
+			CitationThreshold:     opts.CitationThreshold,
+			EnableClaimLevelScore: opts.ClaimLevelScores,
+		},
+	}
+	if opts.CitationThreshold == 0 {
+		request.GroundingSpec.CitationThreshold = DefaultCitationThreshold
+	}
+	for _, fact := range facts {
+		request.Facts = append(request.Facts, &discoveryengine.GoogleCloudDiscoveryengineV1GroundingFact{
+			FactText:   fact.FactText,
+			Attributes: fact.Attributes,
+		})
+	}
+
+	groundingConfig := fmt.Sprintf("%s/groundingConfigs/%s", c.locationName(), DefaultGroundingConfigID)
+	response, err := c.service.Projects.Locations.GroundingConfigs.Check(groundingConfig, request).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check grounding: %w", err)
+	}
+
+	result := &GroundingCheckResult{SupportScore: response.SupportScore, Claims: []GroundingClaim{}}
+	for _, claim := range response.Claims {
+		result.Claims = append(result.Claims, GroundingClaim{
+			Text:                   claim.ClaimText,
+			StartPos:               claim.StartPos,
+			EndPos:                 claim.EndPos,
+			Score:                  claim.Score,
+			Citations:              claim.CitationIndices,
+			GroundingCheckRequired: claim.GroundingCheckRequired,
+		})
+	}
+	for i, chunk := range response.CitedChunks {
+		cited := GroundingCitedChunk{Index: int64(i), Text: chunk.ChunkText, Source: chunk.Source}
+		if index, err := strconv.Atoi(chunk.Source); err == nil && index >= 0 && index < len(facts) {
+			cited.Attributes = facts[index].Attributes
+		}
+		result.CitedChunks = append(result.CitedChunks, cited)
+	}
+	return result, nil
+}
+
+// ParseGroundingFacts reads facts from a YAML or JSON list, a .jsonl file
+// with one fact per line, or plain text where blank lines separate facts.
+// List entries may be fact objects or plain strings.
+func ParseGroundingFacts(data []byte, source string) ([]GroundingFact, error) {
+	facts := []GroundingFact{}
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".jsonl", ".ndjson":
+		for i, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			var fact GroundingFact
+			if err := json.Unmarshal([]byte(line), &fact); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid fact: %w", source, i+1, err)
+			}
+			facts = append(facts, fact)
+		}
+	case ".yaml", ".yml", ".json":
+		var entries []yaml.Node
+		if err := yaml.Unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse facts %s: %w", source, err)
+		}
+		for i := range entries {
+			var fact GroundingFact
+			if entries[i].Kind == yaml.ScalarNode {
+				fact.FactText = entries[i].Value
+			} else if err := entries[i].Decode(&fact); err != nil {
+				return nil, fmt.Errorf("%s: fact %d: %w", source, i+1, err)
+			}
+			facts = append(facts, fact)
+		}
+	default:
+		for _, paragraph := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n\n") {
+			if text := strings.TrimSpace(paragraph); text != "" {
+				facts = append(facts, GroundingFact{FactText: text})
+			}
+		}
+	}
+
+	for i, fact := range facts {
+		if strings.TrimSpace(fact.FactText) == "" {
+			return nil, fmt.Errorf("%s: fact %d has no factText", source, i+1)
+		}
+	}
+	if len(facts) == 0 {
+		return nil, fmt.Errorf("no facts found in %s", source)
+	}
+	return facts, nil
+}
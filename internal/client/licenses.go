@@ -0,0 +1,425 @@
+package client
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	// DefaultUserStoreID is the user store every project location provides.
+	DefaultUserStoreID = "default_user_store"
+
+	// LicenseStateAssigned is the assignment state of a user holding a license.
+	LicenseStateAssigned = "ASSIGNED"
+
+	maxUserLicensePageSize = 50
+	maxUserLicenseBatch    = 1000
+)
+
+// LicenseConfig is a subscription: a number of seats of one tier for a term.
+// AssignedCount is counted from the user licenses of the user store.
+type LicenseConfig struct {
+	Name             string `json:"name" yaml:"name"`
+	SubscriptionTier string `json:"subscriptionTier,omitempty" yaml:"subscriptionTier,omitempty"`
+	SubscriptionTerm string `json:"subscriptionTerm,omitempty" yaml:"subscriptionTerm,omitempty"`
+	State            string `json:"state,omitempty" yaml:"state,omitempty"`
+	StartDate        string `json:"startDate,omitempty" yaml:"startDate,omitempty"`
+	EndDate          string `json:"endDate,omitempty" yaml:"endDate,omitempty"`
+	AutoRenew        bool   `json:"autoRenew,omitempty" yaml:"autoRenew,omitempty"`
+	FreeTrial        bool   `json:"freeTrial,omitempty" yaml:"freeTrial,omitempty"`
+	IsDefault        bool   `json:"isDefault,omitempty" yaml:"isDefault,omitempty"`
+	LicenseCount     int64  `json:"licenseCount" yaml:"licenseCount"`
+	AssignedCount    int64  `json:"assignedCount" yaml:"assignedCount"`
+	AvailableCount   int64  `json:"availableCount" yaml:"availableCount"`
+}
+
+// UserLicense is the license state of one user of a user store.
+type UserLicense struct {
+	UserPrincipal string `json:"userPrincipal" yaml:"userPrincipal"`
+	UserProfile   string `json:"userProfile,omitempty" yaml:"userProfile,omitempty"`
+	State         string `json:"state" yaml:"state"`
+	LicenseConfig string `json:"licenseConfig,omitempty" yaml:"licenseConfig,omitempty"`
+	CreateTime    string `json:"createTime,omitempty" yaml:"createTime,omitempty"`
+	UpdateTime    string `json:"updateTime,omitempty" yaml:"updateTime,omitempty"`
+	LastLoginTime string `json:"lastLoginTime,omitempty" yaml:"lastLoginTime,omitempty"`
+}
+
+// LicenseUsage is the user store's subscriptions together with its users.
+type LicenseUsage struct {
+	UserStore            string           `json:"userStore" yaml:"userStore"`
+	DefaultLicenseConfig string           `json:"defaultLicenseConfig,omitempty" yaml:"defaultLicenseConfig,omitempty"`
+	Configs              []*LicenseConfig `json:"configs" yaml:"configs"`
+	Users                []*UserLicense   `json:"-" yaml:"-"`
+}
+
+// UnusedSeatsReport lists seats nobody holds and assigned users who have not
+// signed in within InactiveDays.
+type UnusedSeatsReport struct {
+	UserStore      string           `json:"userStore" yaml:"userStore"`
+	InactiveDays   int              `json:"inactiveDays" yaml:"inactiveDays"`
+	AvailableSeats int64            `json:"availableSeats" yaml:"availableSeats"`
+	Configs        []*LicenseConfig `json:"configs" yaml:"configs"`
+	InactiveUsers  []*UserLicense   `json:"inactiveUsers" yaml:"inactiveUsers"`
+}
+
+// LicenseUpdatePlan splits the users of a bulk assign or unassign into those
+// that need a change and those already in the requested state.
+type LicenseUpdatePlan struct {
+	LicenseConfig string   `json:"licenseConfig,omitempty" yaml:"licenseConfig,omitempty"`
+	Update        []string `json:"update" yaml:"update"`
+	Unchanged     []string `json:"unchanged,omitempty" yaml:"unchanged,omitempty"`
+	Reassigned    []string `json:"reassigned,omitempty" yaml:"reassigned,omitempty"`
+}
+
+// LicenseUpdateResult reports the outcome of a bulk assign or unassign.
+type LicenseUpdateResult struct {
+	Operations   []string `json:"operations" yaml:"operations"`
+	UpdatedCount int64    `json:"updatedCount" yaml:"updatedCount"`
+	FailedCount  int64    `json:"failedCount" yaml:"failedCount"`
+	ErrorSamples []string `json:"errorSamples,omitempty" yaml:"errorSamples,omitempty"`
+}
+
+// ConstructUserStoreName builds the full resource name of a user store
+func (c *GeminiClient) ConstructUserStoreName(userStoreID string) string {
+	if strings.HasPrefix(userStoreID, "projects/") {
+		return userStoreID
+	}
+	if userStoreID == "" {
+		userStoreID = DefaultUserStoreID
+	}
+	return fmt.Sprintf("%s/userStores/%s", c.locationName(), userStoreID)
+}
+
+// ConstructLicenseConfigName builds the full resource name of a license config
+func (c *GeminiClient) ConstructLicenseConfigName(licenseConfigID string) string {
+	if strings.HasPrefix(licenseConfigID, "projects/") {
+		return licenseConfigID
+	}
+	return fmt.Sprintf("%s/licenseConfigs/%s", c.locationName(), licenseConfigID)
+}
+
+// ListUserLicenses lists the users of a user store. A non-empty state only
+// lists users in that license assignment state.
+func (c *GeminiClient) ListUserLicenses(userStoreName, state string) ([]*UserLicense, error) {
+	users := []*UserLicense{}
+	pageToken := ""
+	for {
+		call := c.service.Projects.Locations.UserStores.UserLicenses.List(userStoreName).
+			PageSize(maxUserLicensePageSize)
+		if state != "" {
+			call = call.Filter(fmt.Sprintf("license_assignment_state = %s", strings.ToUpper(state)))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		response, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user licenses: %w", err)
+		}
+		for _, license := range response.UserLicenses {
+			users = append(users, convertUserLicense(license))
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+	return users, nil
+}
+
+// GetLicenseConfig gets a license config
+func (c *GeminiClient) GetLicenseConfig(licenseConfigName string) (*LicenseConfig, error) {
+	config, err := c.service.Projects.Locations.LicenseConfigs.Get(licenseConfigName).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get license config %s: %w", licenseConfigName, err)
+	}
+	return convertLicenseConfig(config), nil
+}
+
+// GetLicenseUsage collects the license configs of a user store and counts
+// their assigned seats. The API has no way to list license configs, so the
+// configs are those referenced by the user store's default, by its users,
+// and by extraConfigs.
+func (c *GeminiClient) GetLicenseUsage(userStoreName string, extraConfigs []string) (*LicenseUsage, error) {
+	userStore, err := c.service.Projects.Locations.UserStores.Get(userStoreName).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user store: %w", err)
+	}
+
+	users, err := c.ListUserLicenses(userStoreName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &LicenseUsage{
+		UserStore:            userStore.Name,
+		DefaultLicenseConfig: userStore.DefaultLicenseConfig,
+		Configs:              []*LicenseConfig{},
+		Users:                users,
+	}
+
+	assigned := map[string]int64{}
+	names := []string{}
+	seen := map[string]bool{}
+	addName := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	addName(userStore.DefaultLicenseConfig)
+	for _, name := range extraConfigs {
+		addName(c.ConstructLicenseConfigName(name))
+	}
+	for _, user := range users {
+		if user.State == LicenseStateAssigned {
+			assigned[user.LicenseConfig]++
+			addName(user.LicenseConfig)
+		}
+	}
+
+	for _, name := range names {
+		config, err := c.GetLicenseConfig(name)
+		if err != nil {
+			return nil, err
+		}
+		config.IsDefault = name == userStore.DefaultLicenseConfig
+		config.AssignedCount = assigned[name]
+		config.AvailableCount = config.LicenseCount - config.AssignedCount
+		usage.Configs = append(usage.Configs, config)
+	}
+	return usage, nil
+}
+
+// UnusedSeats reports seats nobody holds and assigned users whose last
+// sign-in (or assignment, if they never signed in) is older than
+// inactiveDays.
+func (usage *LicenseUsage) UnusedSeats(inactiveDays int, now time.Time) *UnusedSeatsReport {
+	report := &UnusedSeatsReport{
+		UserStore:     usage.UserStore,
+		InactiveDays:  inactiveDays,
+		Configs:       usage.Configs,
+		InactiveUsers: []*UserLicense{},
+	}
+	for _, config := range usage.Configs {
+		if config.State == "ACTIVE" && config.AvailableCount > 0 {
+			report.AvailableSeats += config.AvailableCount
+		}
+	}
+
+	cutoff := now.AddDate(0, 0, -inactiveDays)
+	for _, user := range usage.Users {
+		if user.State != LicenseStateAssigned {
+			continue
+		}
+		last := user.LastLoginTime
+		if last == "" {
+			last = user.UpdateTime
+		}
+		if t, err := time.Parse(time.RFC3339Nano, last); err == nil && t.After(cutoff) {
+			continue
+		}
+		report.InactiveUsers = append(report.InactiveUsers, user)
+	}
+	sort.SliceStable(report.InactiveUsers, func(i, j int) bool {
+		return report.InactiveUsers[i].LastLoginTime < report.InactiveUsers[j].LastLoginTime
+	})
+	return report
+}
+
+// PlanLicenseAssignment works out which users need licenseConfigName.
+// Users holding another license are moved to it and listed as reassigned.
+func PlanLicenseAssignment(users []*UserLicense, licenseConfigName string, principals []string) *LicenseUpdatePlan {
+	current := userLicensesByPrincipal(users)
+	plan := &LicenseUpdatePlan{LicenseConfig: licenseConfigName, Update: []string{}}
+	for _, principal := range principals {
+		user := current[strings.ToLower(principal)]
+		switch {
+		case user != nil && user.State == LicenseStateAssigned && user.LicenseConfig == licenseConfigName:
+			plan.Unchanged = append(plan.Unchanged, principal)
+		case user != nil && user.State == LicenseStateAssigned:
+			plan.Reassigned = append(plan.Reassigned, principal)
+			plan.Update = append(plan.Update, principal)
+		default:
+			plan.Update = append(plan.Update, principal)
+		}
+	}
+	return plan
+}
+
+// PlanLicenseUnassignment works out which users currently hold a license.
+func PlanLicenseUnassignment(users []*UserLicense, principals []string) *LicenseUpdatePlan {
+	current := userLicensesByPrincipal(users)
+	plan := &LicenseUpdatePlan{Update: []string{}}
+	for _, principal := range principals {
+		if user := current[strings.ToLower(principal)]; user != nil && user.State == LicenseStateAssigned {
+			plan.Update = append(plan.Update, principal)
+		} else {
+			plan.Unchanged = append(plan.Unchanged, principal)
+		}
+	}
+	return plan
+}
+
+// AssignLicenses assigns licenseConfigName to users, in batches of 1000.
+func (c *GeminiClient) AssignLicenses(userStoreName, licenseConfigName string, principals []string) (*LicenseUpdateResult, error) {
+	return c.updateUserLicenses(userStoreName, licenseConfigName, principals, false)
+}
+
+// UnassignLicenses removes the license of users, in batches of 1000. With
+// deleteUsers the user licenses are deleted instead of left unassigned.
+func (c *GeminiClient) UnassignLicenses(userStoreName string, principals []string, deleteUsers bool) (*LicenseUpdateResult, error) {
+	return c.updateUserLicenses(userStoreName, "", principals, deleteUsers)
+}
+
+func (c *GeminiClient) updateUserLicenses(userStoreName, licenseConfigName string, principals []string, deleteUnassigned bool) (*LicenseUpdateResult, error) {
+	result := &LicenseUpdateResult{Operations: []string{}}
+	for start := 0; start < len(principals); start += maxUserLicenseBatch {
+		end := start + maxUserLicenseBatch
+		if end > len(principals) {
+			end = len(principals)
+		}
+
+		inline := &discoveryengine.GoogleCloudDiscoveryengineV1BatchUpdateUserLicensesRequestInlineSource{
+			UpdateMask: "licenseConfig",
+		}
+		for _, principal := range principals[start:end] {
+			inline.UserLicenses = append(inline.UserLicenses, &discoveryengine.GoogleCloudDiscoveryengineV1UserLicense{
+				UserPrincipal: principal,
+				LicenseConfig: licenseConfigName,
+			})
+		}
+		request := &discoveryengine.GoogleCloudDiscoveryengineV1BatchUpdateUserLicensesRequest{
+			InlineSource:                 inline,
+			DeleteUnassignedUserLicenses: deleteUnassigned,
+		}
+
+		operation, err := c.service.Projects.Locations.UserStores.BatchUpdateUserLicenses(userStoreName, request).Do()
+		if err != nil {
+			return result, fmt.Errorf("failed to update user licenses %d-%d: %w", start+1, end, err)
+		}
+		result.Operations = append(result.Operations, operation.Name)
+
+		status, err := c.WaitForOperation(operation.Name, 0, 10*time.Minute, nil)
+		if err != nil {
+			return result, fmt.Errorf("failed to update user licenses %d-%d: %w", start+1, end, err)
+		}
+
+		var metadata discoveryengine.GoogleCloudDiscoveryengineV1BatchUpdateUserLicensesMetadata
+		if err := status.DecodeMetadata(&metadata); err == nil {
+			result.UpdatedCount += metadata.SuccessCount
+			result.FailedCount += metadata.FailureCount
+		}
+		var response discoveryengine.GoogleCloudDiscoveryengineV1BatchUpdateUserLicensesResponse
+		if err := status.DecodeResponse(&response); err == nil {
+			result.ErrorSamples = append(result.ErrorSamples, rpcStatusMessages(response.ErrorSamples)...)
+		}
+	}
+	return result, nil
+}
+
+// ParseUserPrincipalsCSV reads user emails from CSV. The email column is the
+// one headed email, user, or userPrincipal; without such a header the first
+// column is used. Blank lines, # comments and duplicates are skipped.
+func ParseUserPrincipalsCSV(data []byte, source string) ([]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	principals := []string{}
+	seen := map[string]bool{}
+	column := 0
+	first := true
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", source, err)
+		}
+
+		if first {
+			first = false
+			if index := principalColumn(record); index >= 0 {
+				column = index
+				continue
+			}
+		}
+		if column >= len(record) {
+			continue
+		}
+		principal := strings.TrimSpace(record[column])
+		if principal == "" || seen[strings.ToLower(principal)] {
+			continue
+		}
+		seen[strings.ToLower(principal)] = true
+		principals = append(principals, principal)
+	}
+
+	if len(principals) == 0 {
+		return nil, fmt.Errorf("no users found in %s", source)
+	}
+	return principals, nil
+}
+
+func principalColumn(header []string) int {
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "email", "user", "userprincipal", "user_principal", "principal":
+			return i
+		}
+	}
+	return -1
+}
+
+func userLicensesByPrincipal(users []*UserLicense) map[string]*UserLicense {
+	byPrincipal := make(map[string]*UserLicense, len(users))
+	for _, user := range users {
+		byPrincipal[strings.ToLower(user.UserPrincipal)] = user
+	}
+	return byPrincipal
+}
+
+func convertUserLicense(license *discoveryengine.GoogleCloudDiscoveryengineV1UserLicense) *UserLicense {
+	return &UserLicense{
+		UserPrincipal: license.UserPrincipal,
+		UserProfile:   license.UserProfile,
+		State:         license.LicenseAssignmentState,
+		LicenseConfig: license.LicenseConfig,
+		CreateTime:    license.CreateTime,
+		UpdateTime:    license.UpdateTime,
+		LastLoginTime: license.LastLoginTime,
+	}
+}
+
+func convertLicenseConfig(config *discoveryengine.GoogleCloudDiscoveryengineV1LicenseConfig) *LicenseConfig {
+	return &LicenseConfig{
+		Name:             config.Name,
+		SubscriptionTier: config.SubscriptionTier,
+		SubscriptionTerm: config.SubscriptionTerm,
+		State:            config.State,
+		StartDate:        formatGoogleDate(config.StartDate),
+		EndDate:          formatGoogleDate(config.EndDate),
+		AutoRenew:        config.AutoRenew,
+		FreeTrial:        config.FreeTrial,
+		LicenseCount:     config.LicenseCount,
+	}
+}
+
+func formatGoogleDate(date *discoveryengine.GoogleTypeDate) string {
+	if date == nil || date.Year == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
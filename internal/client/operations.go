@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	defaultOperationPollInterval = 5 * time.Second
+	defaultOperationTimeout      = 5 * time.Minute
+)
+
+// OperationStatus summarizes a long-running operation.
+type OperationStatus struct {
+	Name     string                 `json:"name"`
+	Done     bool                   `json:"done"`
+	Error    string                 `json:"error,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Response map[string]interface{} `json:"response,omitempty"`
+
+	rawMetadata []byte
+	rawResponse []byte
+}
+
+// DecodeMetadata unmarshals the operation metadata into v.
+func (s *OperationStatus) DecodeMetadata(v interface{}) error {
+	if len(s.rawMetadata) == 0 {
+		return nil
+	}
+	return json.Unmarshal(s.rawMetadata, v)
+}
+
+// DecodeResponse unmarshals the operation response into v.
+func (s *OperationStatus) DecodeResponse(v interface{}) error {
+	if len(s.rawResponse) == 0 {
+		return nil
+	}
+	return json.Unmarshal(s.rawResponse, v)
+}
+
+// GetOperation retrieves the status of a long-running operation by name.
+func (c *GeminiClient) GetOperation(operationName string) (*OperationStatus, error) {
+	operation, err := c.service.Projects.Locations.Operations.Get(operationName).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check operation status: %w", err)
+	}
+	return convertOperation(operation), nil
+}
+
+// WaitForOperation polls an operation until it completes or timeout elapses.
+// onPoll, when set, is called with each intermediate status. Zero interval and
+// timeout values use the defaults (5s and 5m).
+func (c *GeminiClient) WaitForOperation(operationName string, interval, timeout time.Duration, onPoll func(*OperationStatus)) (*OperationStatus, error) {
+	if interval <= 0 {
+		interval = defaultOperationPollInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultOperationTimeout
+	}
+
+	startTime := time.Now()
+	for {
+		status, err := c.GetOperation(operationName)
+		if err != nil {
+			return nil, err
+		}
+		if status.Done {
+			if status.Error != "" {
+				return status, fmt.Errorf("operation %s failed: %s", operationName, status.Error)
+			}
+			return status, nil
+		}
+		if onPoll != nil {
+			onPoll(status)
+		}
+		if time.Since(startTime) >= timeout {
+			return status, fmt.Errorf("timeout waiting for operation %s", operationName)
+		}
+		time.Sleep(interval)
+	}
+}
+
+func convertOperation(operation *discoveryengine.GoogleLongrunningOperation) *OperationStatus {
+	status := &OperationStatus{
+		Name:        operation.Name,
+		Done:        operation.Done,
+		rawMetadata: operation.Metadata,
+		rawResponse: operation.Response,
+	}
+	if operation.Error != nil {
+		status.Error = fmt.Sprintf("%s (code %d)", operation.Error.Message, operation.Error.Code)
+	}
+	if len(operation.Metadata) > 0 {
+		_ = json.Unmarshal(operation.Metadata, &status.Metadata)
+	}
+	if len(operation.Response) > 0 {
+		_ = json.Unmarshal(operation.Response, &status.Response)
+	}
+	return status
+}
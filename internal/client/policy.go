@@ -0,0 +1,462 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// PolicyTargetEngine evaluates a rule against every engine.
+	PolicyTargetEngine = "engine"
+	// PolicyTargetAgent evaluates a rule against every agent on every assistant.
+	PolicyTargetAgent = "agent"
+	// PolicyTargetDataStore evaluates a rule against every data store.
+	PolicyTargetDataStore = "datastore"
+	// PolicyTargetWorkforce evaluates a rule against the workforce identity configuration.
+	PolicyTargetWorkforce = "workforce"
+
+	// PolicySeverityError marks violations that fail a policy check.
+	PolicySeverityError = "error"
+	// PolicySeverityWarning marks violations that are reported but do not fail a check.
+	PolicySeverityWarning = "warning"
+)
+
+// PolicyCondition asserts something about a field of the evaluated resource.
+// Fields are dot-separated paths into the resource's JSON form.
+type PolicyCondition struct {
+	Field  string   `json:"field" yaml:"field"`
+	Op     string   `json:"op" yaml:"op"`
+	Value  string   `json:"value,omitempty" yaml:"value,omitempty"`
+	Values []string `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+// PolicyRule is a named set of conditions that every matching resource must satisfy.
+type PolicyRule struct {
+	ID          string            `json:"id" yaml:"id"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Severity    string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Target      string            `json:"target" yaml:"target"`
+	Engines     []string          `json:"engines,omitempty" yaml:"engines,omitempty"`
+	IDs         []string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Assert      []PolicyCondition `json:"assert" yaml:"assert"`
+}
+
+// PolicyRuleSet is the on-disk format of a policy file.
+type PolicyRuleSet struct {
+	Rules []PolicyRule `json:"rules" yaml:"rules"`
+}
+
+// PolicyResult is the outcome of evaluating one rule against one resource.
+type PolicyResult struct {
+	RuleID   string `json:"ruleId"`
+	Severity string `json:"severity"`
+	Resource string `json:"resource"`
+	Passed   bool   `json:"passed"`
+	Message  string `json:"message,omitempty"`
+}
+
+// PolicyAgent is an agent together with the engine and assistant it belongs to.
+type PolicyAgent struct {
+	Engine    string
+	Assistant string
+	Agent     *Agent
+}
+
+// PolicyInventory holds the resources a policy check is evaluated against.
+type PolicyInventory struct {
+	Engines    []*Engine
+	Agents     []PolicyAgent
+	DataStores []*DataStore
+	Workforce  *WorkforceIdentityConfig
+}
+
+var policyOps = map[string]struct{}{
+	"equals": {}, "not-equals": {}, "present": {}, "absent": {},
+	"in": {}, "not-in": {}, "matches": {}, "not-matches": {}, "prefix": {}, "not-prefix": {},
+}
+
+// LoadPolicyRules reads and validates policy rules from a YAML or JSON file.
+func LoadPolicyRules(filePath string) ([]PolicyRule, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file %s: %w", filePath, err)
+	}
+
+	var ruleSet PolicyRuleSet
+	if err := yaml.Unmarshal(data, &ruleSet); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file: %w", err)
+	}
+	if len(ruleSet.Rules) == 0 {
+		return nil, fmt.Errorf("no rules defined in %s", filePath)
+	}
+
+	seen := map[string]struct{}{}
+	for i := range ruleSet.Rules {
+		rule := &ruleSet.Rules[i]
+		if rule.ID == "" {
+			return nil, fmt.Errorf("rule %d: id is required", i+1)
+		}
+		if _, ok := seen[rule.ID]; ok {
+			return nil, fmt.Errorf("duplicate rule id %q", rule.ID)
+		}
+		seen[rule.ID] = struct{}{}
+
+		rule.Target = strings.ToLower(rule.Target)
+		switch rule.Target {
+		case PolicyTargetEngine, PolicyTargetAgent, PolicyTargetDataStore, PolicyTargetWorkforce:
+		default:
+			return nil, fmt.Errorf("rule %s: unknown target %q (use engine, agent, datastore, or workforce)", rule.ID, rule.Target)
+		}
+
+		rule.Severity = strings.ToLower(rule.Severity)
+		switch rule.Severity {
+		case "":
+			rule.Severity = PolicySeverityError
+		case PolicySeverityError, PolicySeverityWarning:
+		default:
+			return nil, fmt.Errorf("rule %s: unknown severity %q (use error or warning)", rule.ID, rule.Severity)
+		}
+
+		if len(rule.Assert) == 0 {
+			return nil, fmt.Errorf("rule %s: at least one assert condition is required", rule.ID)
+		}
+		for _, condition := range rule.Assert {
+			if condition.Field == "" {
+				return nil, fmt.Errorf("rule %s: condition field is required", rule.ID)
+			}
+			if _, ok := policyOps[condition.Op]; !ok {
+				return nil, fmt.Errorf("rule %s: unknown op %q", rule.ID, condition.Op)
+			}
+			if condition.Op == "matches" || condition.Op == "not-matches" {
+				if _, err := regexp.Compile(condition.Value); err != nil {
+					return nil, fmt.Errorf("rule %s: invalid pattern %q: %w", rule.ID, condition.Value, err)
+				}
+			}
+		}
+	}
+
+	return ruleSet.Rules, nil
+}
+
+// PolicyTargets returns the set of targets referenced by rules.
+func PolicyTargets(rules []PolicyRule) map[string]bool {
+	targets := map[string]bool{}
+	for _, rule := range rules {
+		targets[rule.Target] = true
+	}
+	return targets
+}
+
+// CollectPolicyInventory fetches the resources needed to evaluate rules.
+func (c *GeminiClient) CollectPolicyInventory(rules []PolicyRule) (*PolicyInventory, error) {
+	targets := PolicyTargets(rules)
+	inventory := &PolicyInventory{}
+
+	if targets[PolicyTargetEngine] || targets[PolicyTargetAgent] {
+		engines, err := c.ListEngines(c.config.Collection)
+		if err != nil {
+			return nil, err
+		}
+		inventory.Engines = engines
+	}
+
+	if targets[PolicyTargetAgent] {
+		for _, engine := range inventory.Engines {
+			agents, err := c.collectEngineAgents(engine.Name)
+			if err != nil {
+				return nil, err
+			}
+			inventory.Agents = append(inventory.Agents, agents...)
+		}
+	}
+
+	if targets[PolicyTargetDataStore] {
+		dataStores, err := c.ListDataStores()
+		if err != nil {
+			return nil, err
+		}
+		inventory.DataStores = dataStores
+	}
+
+	if targets[PolicyTargetWorkforce] {
+		workforce, err := c.GetWorkforceIdentityConfig()
+		if err != nil {
+			return nil, err
+		}
+		inventory.Workforce = workforce
+	}
+
+	return inventory, nil
+}
+
+func (c *GeminiClient) collectEngineAgents(engineName string) ([]PolicyAgent, error) {
+	assistants, err := c.ListAssistants(engineName)
+	if err != nil {
+		if !isNotFound(err) {
+			return nil, fmt.Errorf("failed to list assistants for %s: %w", engineName, err)
+		}
+		assistants = []*Assistant{{Name: ConstructAssistantName(engineName, defaultAssistantID)}}
+	}
+
+	result := []PolicyAgent{}
+	for _, assistant := range assistants {
+		agents, err := c.ListAgents(assistant.Name)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list agents for %s: %w", assistant.Name, err)
+		}
+		for _, agent := range agents {
+			result = append(result, PolicyAgent{
+				Engine:    engineName,
+				Assistant: extractResourceID(assistant.Name),
+				Agent:     agent,
+			})
+		}
+	}
+	return result, nil
+}
+
+// EvaluatePolicy checks every rule against the matching resources in inventory.
+func EvaluatePolicy(rules []PolicyRule, inventory *PolicyInventory) []PolicyResult {
+	results := []PolicyResult{}
+	for _, rule := range rules {
+		for _, document := range policyDocuments(rule, inventory) {
+			result := PolicyResult{
+				RuleID:   rule.ID,
+				Severity: rule.Severity,
+				Resource: document.resource,
+				Passed:   true,
+			}
+			failures := []string{}
+			for _, condition := range rule.Assert {
+				if message, ok := evaluateCondition(condition, document.fields); !ok {
+					failures = append(failures, message)
+				}
+			}
+			if len(failures) > 0 {
+				result.Passed = false
+				result.Message = strings.Join(failures, "; ")
+				if rule.Description != "" {
+					result.Message = rule.Description + ": " + result.Message
+				}
+			}
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// PolicyViolations counts failed results by severity.
+func PolicyViolations(results []PolicyResult) (errors, warnings int) {
+	for _, result := range results {
+		if result.Passed {
+			continue
+		}
+		if result.Severity == PolicySeverityWarning {
+			warnings++
+		} else {
+			errors++
+		}
+	}
+	return errors, warnings
+}
+
+type policyDocument struct {
+	resource string
+	fields   map[string]interface{}
+}
+
+func policyDocuments(rule PolicyRule, inventory *PolicyInventory) []policyDocument {
+	documents := []policyDocument{}
+	if inventory == nil {
+		return documents
+	}
+
+	switch rule.Target {
+	case PolicyTargetEngine:
+		for _, engine := range inventory.Engines {
+			engineID := extractResourceID(engine.Name)
+			if !policyGlobMatch(rule.Engines, engineID) || !policyGlobMatch(rule.IDs, engineID) {
+				continue
+			}
+			fields := policyFields(engine)
+			fields["id"] = engineID
+			fields["features"] = policyFeatureStates(engine.Features)
+			documents = append(documents, policyDocument{resource: engine.Name, fields: fields})
+		}
+	case PolicyTargetAgent:
+		for _, entry := range inventory.Agents {
+			engineID := extractResourceID(entry.Engine)
+			agentID := extractResourceID(entry.Agent.Name)
+			if !policyGlobMatch(rule.Engines, engineID) || !policyGlobMatch(rule.IDs, agentID) {
+				continue
+			}
+			fields := policyFields(entry.Agent)
+			fields["id"] = agentID
+			fields["engine"] = engineID
+			fields["assistant"] = entry.Assistant
+			fields["kind"] = entry.Agent.Kind()
+			documents = append(documents, policyDocument{resource: entry.Agent.Name, fields: fields})
+		}
+	case PolicyTargetDataStore:
+		for _, dataStore := range inventory.DataStores {
+			dataStoreID := extractResourceID(dataStore.Name)
+			if !policyGlobMatch(rule.IDs, dataStoreID) {
+				continue
+			}
+			fields := policyFields(dataStore)
+			fields["id"] = dataStoreID
+			documents = append(documents, policyDocument{resource: dataStore.Name, fields: fields})
+		}
+	case PolicyTargetWorkforce:
+		if inventory.Workforce != nil {
+			fields := policyFields(inventory.Workforce)
+			fields["configured"] = inventory.Workforce.usesWorkforcePool()
+			documents = append(documents, policyDocument{resource: "aclConfig", fields: fields})
+		}
+	}
+
+	sort.SliceStable(documents, func(i, j int) bool {
+		return documents[i].resource < documents[j].resource
+	})
+	return documents
+}
+
+// policyFeatureStates renders every catalog feature plus any explicitly set
+// feature as ON or OFF, using the same fallbacks as the engine.
+func policyFeatureStates(features map[string]string) map[string]interface{} {
+	states := map[string]interface{}{}
+	render := func(state string) string {
+		switch state {
+		case EngineFeatureStateOn:
+			return "ON"
+		case EngineFeatureStateOff:
+			return "OFF"
+		}
+		return state
+	}
+	for _, definition := range featureCatalog {
+		if definition.Key == FeatureWildcard {
+			continue
+		}
+		states[definition.Key] = render(effectiveFeatureState(features, definition.Key))
+	}
+	for key, state := range features {
+		states[key] = render(state)
+	}
+	return states
+}
+
+func policyFields(value interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+func policyGlobMatch(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupPolicyField(fields map[string]interface{}, fieldPath string) (interface{}, bool) {
+	var current interface{} = fields
+	for _, part := range strings.Split(fieldPath, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	switch v := current.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case []interface{}:
+		return v, len(v) > 0
+	case map[string]interface{}:
+		return v, len(v) > 0
+	}
+	return current, true
+}
+
+func evaluateCondition(condition PolicyCondition, fields map[string]interface{}) (string, bool) {
+	value, present := lookupPolicyField(fields, condition.Field)
+	actual := ""
+	if present {
+		actual = policyValueString(value)
+	}
+
+	switch condition.Op {
+	case "present":
+		return fmt.Sprintf("%s must be set", condition.Field), present
+	case "absent":
+		return fmt.Sprintf("%s must not be set (is %q)", condition.Field, actual), !present
+	case "equals":
+		return fmt.Sprintf("%s is %q, expected %q", condition.Field, actual, condition.Value), strings.EqualFold(actual, condition.Value)
+	case "not-equals":
+		return fmt.Sprintf("%s must not be %q", condition.Field, condition.Value), !strings.EqualFold(actual, condition.Value)
+	case "in":
+		return fmt.Sprintf("%s is %q, expected one of %s", condition.Field, actual, strings.Join(condition.Values, ", ")), policyValueIn(actual, condition.Values)
+	case "not-in":
+		return fmt.Sprintf("%s is %q, which is not allowed", condition.Field, actual), !policyValueIn(actual, condition.Values)
+	case "matches":
+		matched, _ := regexp.MatchString(condition.Value, actual)
+		return fmt.Sprintf("%s is %q, expected to match %s", condition.Field, actual, condition.Value), matched
+	case "not-matches":
+		matched, _ := regexp.MatchString(condition.Value, actual)
+		return fmt.Sprintf("%s is %q, which matches %s", condition.Field, actual, condition.Value), !matched
+	case "prefix":
+		return fmt.Sprintf("%s is %q, expected prefix %q", condition.Field, actual, condition.Value), strings.HasPrefix(actual, condition.Value)
+	case "not-prefix":
+		return fmt.Sprintf("%s is %q, which has prefix %q", condition.Field, actual, condition.Value), !strings.HasPrefix(actual, condition.Value)
+	}
+	return fmt.Sprintf("unknown op %q", condition.Op), false
+}
+
+func policyValueIn(value string, values []string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(value, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool, float64:
+		return fmt.Sprintf("%v", v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
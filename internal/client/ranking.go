@@ -0,0 +1,135 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/discoveryengine/v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultRankingConfigID is the ranking config every project location provides.
+	DefaultRankingConfigID = "default_ranking_config"
+
+	maxRankRecordsPerRequest = 200
+)
+
+// RankingRecord is a record scored against a query by the ranking API.
+type RankingRecord struct {
+	ID      string  `json:"id" yaml:"id"`
+	Title   string  `json:"title,omitempty" yaml:"title,omitempty"`
+	Content string  `json:"content,omitempty" yaml:"content,omitempty"`
+	Score   float64 `json:"score" yaml:"score"`
+}
+
+// RankOptions tunes a rank request.
+type RankOptions struct {
+	Model            string
+	TopN             int
+	IgnoreRecordText bool
+}
+
+// RankRecords scores records against a query and returns them ordered by
+// descending score. Records are sent in batches of 200; scores are
+// independent per record, so batches are merged before TopN is applied.
+func (c *GeminiClient) RankRecords(query string, records []RankingRecord, opts RankOptions) ([]RankingRecord, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("at least one record is required")
+	}
+
+	rankingConfig := fmt.Sprintf("%s/rankingConfigs/%s", c.locationName(), DefaultRankingConfigID)
+	ranked := []RankingRecord{}
+	for start := 0; start < len(records); start += maxRankRecordsPerRequest {
+		end := start + maxRankRecordsPerRequest
+		if end > len(records) {
+			end = len(records)
+		}
+
+		request := &discoveryengine.GoogleCloudDiscoveryengineV1RankRequest{
+			Query:                         query,
+			Model:                         opts.Model,
+			IgnoreRecordDetailsInResponse: opts.IgnoreRecordText,
+		}
+		for _, record := range records[start:end] {
+			request.Records = append(request.Records, &discoveryengine.GoogleCloudDiscoveryengineV1RankingRecord{
+				Id:      record.ID,
+				Title:   record.Title,
+				Content: record.Content,
+			})
+		}
+
+		response, err := c.service.Projects.Locations.RankingConfigs.Rank(rankingConfig, request).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rank records %d-%d: %w", start+1, end, err)
+		}
+		for _, record := range response.Records {
+			ranked = append(ranked, RankingRecord{
+				ID:      record.Id,
+				Title:   record.Title,
+				Content: record.Content,
+				Score:   record.Score,
+			})
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	if opts.TopN > 0 && len(ranked) > opts.TopN {
+		ranked = ranked[:opts.TopN]
+	}
+	return ranked, nil
+}
+
+// ParseRankingRecords reads records from a YAML or JSON list, or from a .jsonl
+// file with one record per line. Records without an ID are numbered by their
+// position, and every record needs a title or content.
+func ParseRankingRecords(data []byte, source string) ([]RankingRecord, error) {
+	records := []RankingRecord{}
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".jsonl", ".ndjson":
+		for i, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			var record RankingRecord
+			if err := json.Unmarshal([]byte(line), &record); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid record: %w", source, i+1, err)
+			}
+			records = append(records, record)
+		}
+	default:
+		if err := yaml.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse records %s: %w", source, err)
+		}
+	}
+
+	seen := make(map[string]bool, len(records))
+	for i := range records {
+		record := &records[i]
+		record.Score = 0
+		if record.ID == "" {
+			record.ID = strconv.Itoa(i + 1)
+		}
+		if seen[record.ID] {
+			return nil, fmt.Errorf("%s: duplicate record id %q", source, record.ID)
+		}
+		seen[record.ID] = true
+		if strings.TrimSpace(record.Title) == "" && strings.TrimSpace(record.Content) == "" {
+			return nil, fmt.Errorf("%s: record %q needs a title or content", source, record.ID)
+		}
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no records found in %s", source)
+	}
+	return records, nil
+}
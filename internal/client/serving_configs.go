@@ -0,0 +1,336 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DefaultServingConfigID is the serving config every search engine is created with.
+const DefaultServingConfigID = "default_search"
+
+// ServingConfig controls how an engine serves search and answer requests,
+// including which controls are applied.
+type ServingConfig struct {
+	Name                     string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	DisplayName              string                 `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	SolutionType             string                 `json:"solutionType,omitempty" yaml:"solutionType,omitempty"`
+	ModelID                  string                 `json:"modelId,omitempty" yaml:"modelId,omitempty"`
+	DiversityLevel           string                 `json:"diversityLevel,omitempty" yaml:"diversityLevel,omitempty"`
+	RankingExpression        string                 `json:"rankingExpression,omitempty" yaml:"rankingExpression,omitempty"`
+	BoostControlIds          []string               `json:"boostControlIds,omitempty" yaml:"boostControlIds,omitempty"`
+	FilterControlIds         []string               `json:"filterControlIds,omitempty" yaml:"filterControlIds,omitempty"`
+	RedirectControlIds       []string               `json:"redirectControlIds,omitempty" yaml:"redirectControlIds,omitempty"`
+	SynonymsControlIds       []string               `json:"synonymsControlIds,omitempty" yaml:"synonymsControlIds,omitempty"`
+	PromoteControlIds        []string               `json:"promoteControlIds,omitempty" yaml:"promoteControlIds,omitempty"`
+	OnewaySynonymsControlIds []string               `json:"onewaySynonymsControlIds,omitempty" yaml:"onewaySynonymsControlIds,omitempty"`
+	DissociateControlIds     []string               `json:"dissociateControlIds,omitempty" yaml:"dissociateControlIds,omitempty"`
+	ReplacementControlIds    []string               `json:"replacementControlIds,omitempty" yaml:"replacementControlIds,omitempty"`
+	IgnoreControlIds         []string               `json:"ignoreControlIds,omitempty" yaml:"ignoreControlIds,omitempty"`
+	GenericConfig            map[string]interface{} `json:"genericConfig,omitempty" yaml:"genericConfig,omitempty"`
+	MediaConfig              map[string]interface{} `json:"mediaConfig,omitempty" yaml:"mediaConfig,omitempty"`
+	AnswerGenerationSpec     map[string]interface{} `json:"answerGenerationSpec,omitempty" yaml:"answerGenerationSpec,omitempty"`
+	CreateTime               string                 `json:"createTime,omitempty" yaml:"createTime,omitempty"`
+	UpdateTime               string                 `json:"updateTime,omitempty" yaml:"updateTime,omitempty"`
+}
+
+// ServingConfigUpdatableFields lists the serving config fields gemctl can update
+var ServingConfigUpdatableFields = []string{
+	"displayName",
+	"modelId",
+	"diversityLevel",
+	"rankingExpression",
+	"boostControlIds",
+	"filterControlIds",
+	"redirectControlIds",
+	"synonymsControlIds",
+	"promoteControlIds",
+	"onewaySynonymsControlIds",
+	"dissociateControlIds",
+	"replacementControlIds",
+	"ignoreControlIds",
+	"genericConfig",
+	"mediaConfig",
+	"answerGenerationSpec",
+}
+
+// controlIDFields maps control action types to the serving config field that links them.
+var controlIDFields = map[string]string{
+	ControlTypeBoost:    "boostControlIds",
+	ControlTypeFilter:   "filterControlIds",
+	ControlTypeRedirect: "redirectControlIds",
+	ControlTypeSynonyms: "synonymsControlIds",
+	ControlTypePromote:  "promoteControlIds",
+}
+
+// LinkedControlIDs returns the IDs of all controls linked to the serving config, by field.
+func (s *ServingConfig) LinkedControlIDs() map[string][]string {
+	linked := map[string][]string{}
+	for field, ids := range s.controlIDSlices() {
+		if len(*ids) > 0 {
+			linked[field] = append([]string{}, (*ids)...)
+		}
+	}
+	return linked
+}
+
+func (s *ServingConfig) controlIDSlices() map[string]*[]string {
+	return map[string]*[]string{
+		"boostControlIds":          &s.BoostControlIds,
+		"filterControlIds":         &s.FilterControlIds,
+		"redirectControlIds":       &s.RedirectControlIds,
+		"synonymsControlIds":       &s.SynonymsControlIds,
+		"promoteControlIds":        &s.PromoteControlIds,
+		"onewaySynonymsControlIds": &s.OnewaySynonymsControlIds,
+		"dissociateControlIds":     &s.DissociateControlIds,
+		"replacementControlIds":    &s.ReplacementControlIds,
+		"ignoreControlIds":         &s.IgnoreControlIds,
+	}
+}
+
+// ListServingConfigs retrieves all serving configs of an engine
+func (c *GeminiClient) ListServingConfigs(engineName string) ([]*ServingConfig, error) {
+	collectionURL := c.resourceURL(engineName) + "/servingConfigs"
+
+	servingConfigs := []*ServingConfig{}
+	pageToken := ""
+	for {
+		pageURL := collectionURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", collectionURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("serving config", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			ServingConfigs []*ServingConfig `json:"servingConfigs"`
+			NextPageToken  string           `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode serving configs response: %w", err)
+		}
+		servingConfigs = append(servingConfigs, response.ServingConfigs...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Slice(servingConfigs, func(i, j int) bool {
+		return servingConfigs[i].Name < servingConfigs[j].Name
+	})
+	return servingConfigs, nil
+}
+
+// GetServingConfig retrieves a serving config by resource name
+func (c *GeminiClient) GetServingConfig(servingConfigName string) (*ServingConfig, error) {
+	body, err := c.doAPIRequest("serving config", http.MethodGet, c.resourceURL(servingConfigName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servingConfig ServingConfig
+	if err := json.Unmarshal(body, &servingConfig); err != nil {
+		return nil, fmt.Errorf("failed to decode serving config response: %w", err)
+	}
+	return &servingConfig, nil
+}
+
+// UpdateServingConfig patches a serving config using the provided update mask
+func (c *GeminiClient) UpdateServingConfig(servingConfigName string, servingConfig *ServingConfig, updateMask []string) (*ServingConfig, error) {
+	if servingConfig == nil {
+		return nil, fmt.Errorf("serving config update payload is required")
+	}
+
+	updateURL := c.resourceURL(servingConfigName)
+	if len(updateMask) > 0 {
+		updateURL = fmt.Sprintf("%s?%s", updateURL, urlValuesFromMask(updateMask).Encode())
+	}
+
+	payload := *servingConfig
+	payload.Name = ""
+	payload.SolutionType = ""
+	payload.CreateTime = ""
+	payload.UpdateTime = ""
+
+	body, err := c.doAPIRequest("serving config", http.MethodPatch, updateURL, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update serving config %s: %w", extractResourceID(servingConfigName), err)
+	}
+
+	var updated ServingConfig
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode updated serving config: %w", err)
+	}
+	return &updated, nil
+}
+
+// LinkControl attaches a control to a serving config. Linking an already
+// linked control is a no-op.
+func (c *GeminiClient) LinkControl(servingConfigName string, control *Control) (*ServingConfig, error) {
+	return c.setControlLink(servingConfigName, control, true)
+}
+
+// UnlinkControl detaches a control from a serving config. Unlinking a control
+// that is not linked is a no-op.
+func (c *GeminiClient) UnlinkControl(servingConfigName string, control *Control) (*ServingConfig, error) {
+	return c.setControlLink(servingConfigName, control, false)
+}
+
+func (c *GeminiClient) setControlLink(servingConfigName string, control *Control, linked bool) (*ServingConfig, error) {
+	field, ok := controlIDFields[control.ActionType()]
+	if !ok {
+		return nil, fmt.Errorf("control %s has no action", control.ControlID())
+	}
+
+	servingConfig, err := c.GetServingConfig(servingConfigName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := servingConfig.controlIDSlices()[field]
+	id := control.ControlID()
+	updated := []string{}
+	found := false
+	for _, existing := range *ids {
+		if existing == id {
+			found = true
+			if !linked {
+				continue
+			}
+		}
+		updated = append(updated, existing)
+	}
+	if found == linked {
+		return servingConfig, nil
+	}
+	if linked {
+		updated = append(updated, id)
+	}
+	*ids = updated
+
+	return c.UpdateServingConfig(servingConfigName, servingConfig, []string{field})
+}
+
+// DiffServingConfigFields returns the update mask needed to turn current into desired.
+func DiffServingConfigFields(current, desired *ServingConfig) []string {
+	if desired == nil {
+		return nil
+	}
+	if current == nil {
+		current = &ServingConfig{}
+	}
+
+	currentValues := servingConfigFieldValues(current)
+	desiredValues := servingConfigFieldValues(desired)
+	mask := []string{}
+	for _, field := range ServingConfigUpdatableFields {
+		if field == "displayName" && desired.DisplayName == "" {
+			continue
+		}
+		if !jsonValuesEqual(currentValues[field], desiredValues[field]) {
+			mask = append(mask, field)
+		}
+	}
+	return mask
+}
+
+// ConstructServingConfigName constructs the fully-qualified serving config resource name
+func ConstructServingConfigName(engineName, servingConfigID string) string {
+	if servingConfigID == "" {
+		servingConfigID = DefaultServingConfigID
+	}
+	if strings.Contains(servingConfigID, "/") {
+		return servingConfigID
+	}
+	return fmt.Sprintf("%s/servingConfigs/%s", strings.TrimPrefix(engineName, "/"), servingConfigID)
+}
+
+// diffServingConfigs compares serving configs by ID. Serving configs cannot be
+// created or deleted, so only field changes of configs present on both sides
+// are reported.
+func diffServingConfigs(a, b []*ServingConfig) []FieldDiff {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	current := servingConfigsByID(a)
+	diffs := []FieldDiff{}
+	for _, desired := range b {
+		id := extractResourceID(desired.Name)
+		from, ok := current[id]
+		if !ok {
+			continue
+		}
+		diffs = append(diffs, servingConfigFieldDiffs(from, desired, "servingConfigs/"+id)...)
+	}
+	return diffs
+}
+
+// applyServingConfigSnapshots updates serving configs that exist on the
+// target engine to match the snapshot. Snapshot serving configs missing on the
+// target are skipped because serving configs cannot be created.
+func (c *GeminiClient) applyServingConfigSnapshots(engineName string, current, desired []*ServingConfig) ([]FieldDiff, error) {
+	existing := servingConfigsByID(current)
+
+	changes := []FieldDiff{}
+	for _, servingConfig := range desired {
+		id := extractResourceID(servingConfig.Name)
+		target, ok := existing[id]
+		if !ok {
+			continue
+		}
+		mask := DiffServingConfigFields(target, servingConfig)
+		if len(mask) == 0 {
+			continue
+		}
+		if _, err := c.UpdateServingConfig(ConstructServingConfigName(engineName, id), servingConfig, mask); err != nil {
+			return changes, err
+		}
+		changes = append(changes, servingConfigFieldDiffs(target, servingConfig, "servingConfigs/"+id)...)
+	}
+	return changes, nil
+}
+
+func servingConfigFieldDiffs(current, desired *ServingConfig, prefix string) []FieldDiff {
+	mask := DiffServingConfigFields(current, desired)
+	if len(mask) == 0 {
+		return nil
+	}
+
+	oldValues := servingConfigFieldValues(current)
+	newValues := servingConfigFieldValues(desired)
+	diffs := make([]FieldDiff, 0, len(mask))
+	for _, field := range mask {
+		diffs = append(diffs, FieldDiff{Field: prefix + "." + field, Old: oldValues[field], New: newValues[field]})
+	}
+	return diffs
+}
+
+func servingConfigsByID(servingConfigs []*ServingConfig) map[string]*ServingConfig {
+	byID := make(map[string]*ServingConfig, len(servingConfigs))
+	for _, servingConfig := range servingConfigs {
+		if servingConfig == nil || servingConfig.Name == "" {
+			continue
+		}
+		byID[extractResourceID(servingConfig.Name)] = servingConfig
+	}
+	return byID
+}
+
+func servingConfigFieldValues(servingConfig *ServingConfig) map[string]interface{} {
+	values := map[string]interface{}{}
+	data, err := json.Marshal(servingConfig)
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(data, &values)
+	return values
+}
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	defaultSessionConcurrency = 4
+	maxSessionPageSize        = 1000
+)
+
+// Session is a conversation of a user with an engine's assistant or answer API.
+type Session struct {
+	Name         string         `json:"name" yaml:"name"`
+	DisplayName  string         `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	UserPseudoID string         `json:"userPseudoId,omitempty" yaml:"userPseudoId,omitempty"`
+	State        string         `json:"state,omitempty" yaml:"state,omitempty"`
+	StartTime    string         `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	EndTime      string         `json:"endTime,omitempty" yaml:"endTime,omitempty"`
+	IsPinned     bool           `json:"isPinned,omitempty" yaml:"isPinned,omitempty"`
+	Labels       []string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Turns        []*SessionTurn `json:"turns,omitempty" yaml:"turns,omitempty"`
+}
+
+// SessionTurn is one query of a session and the answer it received.
+// AnswerText is only set when the session was fetched with answer details.
+type SessionTurn struct {
+	QueryID    string `json:"queryId,omitempty" yaml:"queryId,omitempty"`
+	Query      string `json:"query,omitempty" yaml:"query,omitempty"`
+	Answer     string `json:"answer,omitempty" yaml:"answer,omitempty"`
+	AnswerText string `json:"answerText,omitempty" yaml:"answerText,omitempty"`
+}
+
+// SessionFilter selects sessions by user and creation time. Zero values are
+// not filtered on.
+type SessionFilter struct {
+	UserPseudoID  string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// SessionDeleteResult reports the outcome of deleting one session.
+type SessionDeleteResult struct {
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+	Error  string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// IsEmpty reports whether the filter matches every session.
+func (f SessionFilter) IsEmpty() bool {
+	return f.UserPseudoID == "" && f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero()
+}
+
+// Expression returns the filter in the list sessions filter syntax.
+func (f SessionFilter) Expression() string {
+	terms := []string{}
+	if f.UserPseudoID != "" {
+		terms = append(terms, fmt.Sprintf("user_pseudo_id = %q", f.UserPseudoID))
+	}
+	if !f.CreatedAfter.IsZero() {
+		terms = append(terms, fmt.Sprintf("create_time > %q", f.CreatedAfter.UTC().Format(time.RFC3339)))
+	}
+	if !f.CreatedBefore.IsZero() {
+		terms = append(terms, fmt.Sprintf("create_time < %q", f.CreatedBefore.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// ParseSessionTime parses an RFC 3339 timestamp, a YYYY-MM-DD date (midnight
+// UTC), or a duration before now such as 90m, 24h or 7d.
+func ParseSessionTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use RFC 3339 (2024-05-01T12:00:00Z), a date (2024-05-01), or a duration ago (24h, 7d)", value)
+}
+
+// ConstructSessionName builds the full resource name of a session.
+func ConstructSessionName(engineName, sessionID string) string {
+	if strings.HasPrefix(sessionID, "projects/") {
+		return sessionID
+	}
+	return fmt.Sprintf("%s/sessions/%s", strings.TrimSuffix(engineName, "/"), sessionID)
+}
+
+// ListSessions lists the sessions of an engine matching filter, newest
+// first. A positive limit stops after that many sessions.
+func (c *GeminiClient) ListSessions(engineName string, filter SessionFilter, limit int) ([]*Session, error) {
+	sessions := []*Session{}
+	pageToken := ""
+	for {
+		pageSize := maxSessionPageSize
+		if limit > 0 && limit-len(sessions) < pageSize {
+			pageSize = limit - len(sessions)
+		}
+
+		call := c.service.Projects.Locations.Collections.Engines.Sessions.List(engineName).
+			OrderBy("create_time desc").
+			PageSize(int64(pageSize))
+		if expression := filter.Expression(); expression != "" {
+			call = call.Filter(expression)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		response, err := call.Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		for _, session := range response.Sessions {
+			sessions = append(sessions, convertSession(session))
+		}
+
+		if response.NextPageToken == "" || (limit > 0 && len(sessions) >= limit) {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	if limit > 0 && len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// GetSession gets a session. With includeAnswers the answer text of each
+// turn is filled in.
+func (c *GeminiClient) GetSession(sessionName string, includeAnswers bool) (*Session, error) {
+	call := c.service.Projects.Locations.Collections.Engines.Sessions.Get(sessionName)
+	if includeAnswers {
+		call = call.IncludeAnswerDetails(true)
+	}
+	session, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return convertSession(session), nil
+}
+
+// DeleteSession deletes a session and its turns.
+func (c *GeminiClient) DeleteSession(sessionName string) error {
+	if _, err := c.service.Projects.Locations.Collections.Engines.Sessions.Delete(sessionName).Do(); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// DeleteSessions deletes sessions concurrently and returns one result per
+// session, in the order given.
+func (c *GeminiClient) DeleteSessions(sessionNames []string, concurrency int) []SessionDeleteResult {
+	if concurrency <= 0 {
+		concurrency = defaultSessionConcurrency
+	}
+
+	results := make([]SessionDeleteResult, len(sessionNames))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, name := range sessionNames {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := SessionDeleteResult{Name: name, Status: "deleted"}
+			if err := c.DeleteSession(name); err != nil {
+				result.Status = "error"
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, name)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func convertSession(session *discoveryengine.GoogleCloudDiscoveryengineV1Session) *Session {
+	result := &Session{
+		Name:         session.Name,
+		DisplayName:  session.DisplayName,
+		UserPseudoID: session.UserPseudoId,
+		State:        session.State,
+		StartTime:    session.StartTime,
+		EndTime:      session.EndTime,
+		IsPinned:     session.IsPinned,
+		Labels:       session.Labels,
+	}
+	for _, turn := range session.Turns {
+		converted := &SessionTurn{Answer: turn.Answer}
+		if turn.Query != nil {
+			converted.QueryID = turn.Query.QueryId
+			converted.Query = turn.Query.Text
+		}
+		if turn.DetailedAnswer != nil {
+			converted.AnswerText = turn.DetailedAnswer.AnswerText
+		}
+		if turn.DetailedAssistAnswer != nil {
+			replies := []string{}
+			for _, reply := range turn.DetailedAssistAnswer.Replies {
+				if reply.GroundedContent == nil || reply.GroundedContent.Content == nil || reply.GroundedContent.Content.Thought {
+					continue
+				}
+				replies = append(replies, reply.GroundedContent.Content.Text)
+			}
+			converted.AnswerText = strings.Join(replies, "")
+		}
+		result.Turns = append(result.Turns, converted)
+	}
+	return result
+}
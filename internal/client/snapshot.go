@@ -26,6 +26,7 @@ type SnapshotMetadata struct {
 	DisplayName        string `json:"displayName,omitempty"`
 	Description        string `json:"description,omitempty"`
 	Notes              string `json:"notes,omitempty"`
+	Redacted           bool   `json:"redacted,omitempty"`
 }
 
 // EngineConfigSnapshot represents the engine configuration captured in a snapshot.
@@ -41,18 +42,40 @@ type EngineConfigSnapshot struct {
 }
 
 // EngineSnapshot bundles metadata, engine configuration, and related agents.
+// Agents and Assistant describe the default assistant; Assistants holds any
+// additional assistants together with their agents.
 type EngineSnapshot struct {
-	Metadata SnapshotMetadata     `json:"metadata"`
-	Engine   EngineConfigSnapshot `json:"engine"`
-	Agents   []*Agent             `json:"agents,omitempty"`
+	Metadata   SnapshotMetadata     `json:"metadata"`
+	Engine     EngineConfigSnapshot `json:"engine"`
+	Agents     []*Agent             `json:"agents,omitempty"`
+	Assistant  *Assistant           `json:"assistant,omitempty"`
+	Assistants []*AssistantSnapshot `json:"assistants,omitempty"`
+	Connectors []*DataConnector     `json:"connectors,omitempty"`
+	// ConnectorsCaptured marks snapshots whose Connectors list is complete, so
+	// an empty list means the engine has no connectors. Snapshots taken before
+	// connectors were captured, or whose connectors could not be listed, leave
+	// it unset.
+	ConnectorsCaptured bool `json:"connectorsCaptured,omitempty"`
+	// Warnings lists parts of the engine that could not be captured.
+	Warnings []string   `json:"warnings,omitempty"`
+	Controls []*Control `json:"controls,omitempty"`
+	// ServingConfigs is nil only in snapshots taken before controls were
+	// captured, since every engine has at least one serving config.
+	ServingConfigs []*ServingConfig   `json:"servingConfigs,omitempty"`
+	Integrity      *SnapshotIntegrity `json:"integrity,omitempty"`
 }
 
 // SnapshotDiff summarizes differences between two snapshots or between a snapshot and live state.
 type SnapshotDiff struct {
-	MetadataChanges []FieldDiff   `json:"metadataChanges,omitempty"`
-	EngineChanges   []FieldDiff   `json:"engineChanges,omitempty"`
-	FeatureChanges  []FeatureDiff `json:"featureChanges,omitempty"`
-	AgentChanges    []AgentDiff   `json:"agentChanges,omitempty"`
+	MetadataChanges      []FieldDiff   `json:"metadataChanges,omitempty"`
+	EngineChanges        []FieldDiff   `json:"engineChanges,omitempty"`
+	FeatureChanges       []FeatureDiff `json:"featureChanges,omitempty"`
+	AgentChanges         []AgentDiff   `json:"agentChanges,omitempty"`
+	AssistantChanges     []FieldDiff   `json:"assistantChanges,omitempty"`
+	ConnectorChanges     []FieldDiff   `json:"connectorChanges,omitempty"`
+	ControlChanges       []FieldDiff   `json:"controlChanges,omitempty"`
+	ServingConfigChanges []FieldDiff   `json:"servingConfigChanges,omitempty"`
+	Warnings             []string      `json:"warnings,omitempty"`
 }
 
 // FieldDiff represents a change in a simple field.
@@ -87,6 +110,7 @@ type AgentDiff struct {
 	ChangeType AgentDiffKind `json:"changeType"`
 	Old        *Agent        `json:"old,omitempty"`
 	New        *Agent        `json:"new,omitempty"`
+	UpdateMask []string      `json:"updateMask,omitempty"`
 }
 
 // SnapshotRestoreOptions control how a snapshot is restored.
@@ -95,18 +119,23 @@ type SnapshotRestoreOptions struct {
 	CreateIfMissing  bool
 	UpdateExisting   bool
 	DryRun           bool
+	AllowRedacted    bool
 }
 
 // SnapshotRestoreResult reports actions taken during restore.
 type SnapshotRestoreResult struct {
-	EngineName     string        `json:"engineName"`
-	Created        bool          `json:"created"`
-	EnginePatched  bool          `json:"enginePatched"`
-	FeatureChanges []FeatureDiff `json:"featureChanges,omitempty"`
-	AgentChanges   []AgentDiff   `json:"agentChanges,omitempty"`
+	EngineName           string        `json:"engineName"`
+	Created              bool          `json:"created"`
+	EnginePatched        bool          `json:"enginePatched"`
+	FeatureChanges       []FeatureDiff `json:"featureChanges,omitempty"`
+	AgentChanges         []AgentDiff   `json:"agentChanges,omitempty"`
+	AssistantChanges     []FieldDiff   `json:"assistantChanges,omitempty"`
+	ControlChanges       []FieldDiff   `json:"controlChanges,omitempty"`
+	ServingConfigChanges []FieldDiff   `json:"servingConfigChanges,omitempty"`
 }
 
-// CreateEngineSnapshot captures the engine configuration and registered agents.
+// CreateEngineSnapshot captures the engine configuration, assistant settings, registered agents,
+// controls and serving configs, and the data connectors feeding the engine's data stores.
 func (c *GeminiClient) CreateEngineSnapshot(engineName string) (*EngineSnapshot, error) {
 	engine, err := c.GetEngineDetails(engineName)
 	if err != nil {
@@ -118,6 +147,28 @@ func (c *GeminiClient) CreateEngineSnapshot(engineName string) (*EngineSnapshot,
 		return nil, fmt.Errorf("failed to list agents: %w", err)
 	}
 
+	assistant, err := c.getSnapshotAssistant(engineName)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalAssistants, err := c.captureAdditionalAssistants(engineName)
+	if err != nil {
+		return nil, err
+	}
+
+	controls, err := c.captureControls(engineName)
+	if err != nil {
+		return nil, err
+	}
+
+	servingConfigs, err := c.captureServingConfigs(engineName)
+	if err != nil {
+		return nil, err
+	}
+
+	connectors, connectorsErr := c.captureEngineConnectors(engine.DataStoreIds)
+
 	engineID := extractResourceID(engine.Name)
 
 	configSnapshot := EngineConfigSnapshot{
@@ -142,11 +193,17 @@ func (c *GeminiClient) CreateEngineSnapshot(engineName string) (*EngineSnapshot,
 		TakenAt:            time.Now().UTC().Format(time.RFC3339),
 	}
 
-	return &EngineSnapshot{
-		Metadata: metadata,
-		Engine:   configSnapshot,
-		Agents:   cloneAgents(agents),
-	}, nil
+	snapshot := &EngineSnapshot{
+		Metadata:       metadata,
+		Engine:         configSnapshot,
+		Agents:         cloneAgents(agents),
+		Assistant:      assistant,
+		Assistants:     additionalAssistants,
+		Controls:       controls,
+		ServingConfigs: servingConfigs,
+	}
+	snapshot.setConnectors(connectors, connectorsErr)
+	return snapshot, nil
 }
 
 // Serialize snapshot to JSON bytes.
@@ -165,12 +222,33 @@ func LoadEngineSnapshot(data []byte) (*EngineSnapshot, error) {
 
 // DiffSnapshots compares two snapshots.
 func DiffSnapshots(a, b *EngineSnapshot) SnapshotDiff {
+	return diffSnapshots(a, b, false)
+}
+
+// diffSnapshotWithLive compares a snapshot with live engine state captured as
+// b. Additional assistants that exist only on the engine are not reported,
+// since restore leaves them untouched.
+func diffSnapshotWithLive(snapshot, live *EngineSnapshot) SnapshotDiff {
+	return diffSnapshots(snapshot, live, true)
+}
+
+func diffSnapshots(a, b *EngineSnapshot, live bool) SnapshotDiff {
 	diff := SnapshotDiff{}
 
 	diff.MetadataChanges = append(diff.MetadataChanges, diffMetadata(a.Metadata, b.Metadata)...)
 	diff.EngineChanges = append(diff.EngineChanges, diffEngineConfig(a.Engine, b.Engine)...)
 	diff.FeatureChanges = append(diff.FeatureChanges, diffFeatures(a.Engine.Features, b.Engine.Features)...)
 	diff.AgentChanges = append(diff.AgentChanges, diffAgents(a.Agents, b.Agents)...)
+	diff.AssistantChanges = append(diff.AssistantChanges, diffAssistant(a.Assistant, b.Assistant, "assistant")...)
+
+	assistantChanges, assistantAgentChanges := diffAssistantSnapshots(a.Assistants, b.Assistants, live)
+	diff.AssistantChanges = append(diff.AssistantChanges, assistantChanges...)
+	diff.AgentChanges = append(diff.AgentChanges, assistantAgentChanges...)
+	if a.ConnectorsCaptured && b.ConnectorsCaptured {
+		diff.ConnectorChanges = append(diff.ConnectorChanges, diffConnectors(a.Connectors, b.Connectors)...)
+	}
+	diff.ControlChanges = append(diff.ControlChanges, diffSnapshotControls(a, b)...)
+	diff.ServingConfigChanges = append(diff.ServingConfigChanges, diffServingConfigs(a.ServingConfigs, b.ServingConfigs)...)
 
 	return diff
 }
@@ -187,6 +265,28 @@ func (c *GeminiClient) DiffSnapshotWithEngine(snapshot *EngineSnapshot, engineNa
 		return SnapshotDiff{}, fmt.Errorf("failed to list agents: %w", err)
 	}
 
+	assistant, err := c.getSnapshotAssistant(engineName)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	additionalAssistants, err := c.captureAdditionalAssistants(engineName)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	controls, err := c.captureControls(engineName)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	servingConfigs, err := c.captureServingConfigs(engineName)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	connectors, connectorsErr := c.captureEngineConnectors(engine.DataStoreIds)
+
 	currentSnapshot := EngineSnapshot{
 		Metadata: SnapshotMetadata{
 			Version:            snapshotVersion,
@@ -205,10 +305,17 @@ func (c *GeminiClient) DiffSnapshotWithEngine(snapshot *EngineSnapshot, engineNa
 			Features:         cloneStringMap(engine.Features),
 			SearchConfig:     engine.SearchEngineConfig,
 		},
-		Agents: cloneAgents(agents),
+		Agents:         cloneAgents(agents),
+		Assistant:      assistant,
+		Assistants:     additionalAssistants,
+		Controls:       controls,
+		ServingConfigs: servingConfigs,
 	}
+	currentSnapshot.setConnectors(connectors, connectorsErr)
 
-	return DiffSnapshots(snapshot, &currentSnapshot), nil
+	diff := diffSnapshotWithLive(snapshot, &currentSnapshot)
+	diff.Warnings = currentSnapshot.Warnings
+	return diff, nil
 }
 
 // RestoreEngineSnapshot restores snapshot content to a target engine.
@@ -219,9 +326,16 @@ func (c *GeminiClient) RestoreEngineSnapshot(snapshot *EngineSnapshot, opts Snap
 	if opts.TargetEngineName == "" {
 		return nil, SnapshotDiff{}, fmt.Errorf("target engine name is required")
 	}
+	if snapshot.Metadata.Redacted && !opts.AllowRedacted {
+		return nil, SnapshotDiff{}, fmt.Errorf("snapshot has redacted agent fields; restoring it would overwrite real values with placeholders")
+	}
 
 	var existingEngine *Engine
 	var existingAgents []*Agent
+	var existingAssistant *Assistant
+	var existingAssistants []*AssistantSnapshot
+	var existingControls []*Control
+	var existingServingConfigs []*ServingConfig
 	engine, err := c.GetEngineDetails(opts.TargetEngineName)
 	if err != nil {
 		if !isNotFound(err) {
@@ -237,11 +351,35 @@ func (c *GeminiClient) RestoreEngineSnapshot(snapshot *EngineSnapshot, opts Snap
 			return nil, SnapshotDiff{}, fmt.Errorf("failed to list agents for target engine: %w", err)
 		}
 		existingAgents = agents
+
+		assistant, err := c.getSnapshotAssistant(opts.TargetEngineName)
+		if err != nil {
+			return nil, SnapshotDiff{}, err
+		}
+		existingAssistant = assistant
+
+		additionalAssistants, err := c.captureAdditionalAssistants(opts.TargetEngineName)
+		if err != nil {
+			return nil, SnapshotDiff{}, err
+		}
+		existingAssistants = additionalAssistants
+
+		controls, err := c.captureControls(opts.TargetEngineName)
+		if err != nil {
+			return nil, SnapshotDiff{}, err
+		}
+		existingControls = controls
+
+		servingConfigs, err := c.captureServingConfigs(opts.TargetEngineName)
+		if err != nil {
+			return nil, SnapshotDiff{}, err
+		}
+		existingServingConfigs = servingConfigs
 	}
 
 	currentDiff := SnapshotDiff{}
 	if existingEngine != nil {
-		currentDiff = DiffSnapshots(snapshot, &EngineSnapshot{
+		currentDiff = diffSnapshotWithLive(snapshot, &EngineSnapshot{
 			Metadata: SnapshotMetadata{
 				Version:            snapshotVersion,
 				OriginalEngineName: existingEngine.Name,
@@ -259,7 +397,11 @@ func (c *GeminiClient) RestoreEngineSnapshot(snapshot *EngineSnapshot, opts Snap
 				Features:         cloneStringMap(existingEngine.Features),
 				SearchConfig:     existingEngine.SearchEngineConfig,
 			},
-			Agents: cloneAgents(existingAgents),
+			Agents:         cloneAgents(existingAgents),
+			Assistant:      existingAssistant,
+			Assistants:     existingAssistants,
+			Controls:       existingControls,
+			ServingConfigs: existingServingConfigs,
 		})
 	} else {
 		currentDiff.EngineChanges = append(currentDiff.EngineChanges, FieldDiff{
@@ -269,12 +411,25 @@ func (c *GeminiClient) RestoreEngineSnapshot(snapshot *EngineSnapshot, opts Snap
 		})
 		currentDiff.FeatureChanges = diffFeatures(map[string]string{}, snapshot.Engine.Features)
 		currentDiff.AgentChanges = diffAgents(nil, snapshot.Agents)
+		currentDiff.AssistantChanges = diffAssistant(&Assistant{}, snapshot.Assistant, "assistant")
+		assistantChanges, assistantAgentChanges := diffAssistantSnapshots(nil, snapshot.Assistants, false)
+		currentDiff.AssistantChanges = append(currentDiff.AssistantChanges, assistantChanges...)
+		currentDiff.AgentChanges = append(currentDiff.AgentChanges, assistantAgentChanges...)
+		if snapshot.ServingConfigs != nil {
+			currentDiff.ControlChanges = DiffControls(nil, snapshot.Controls, false)
+		}
 	}
 
 	if opts.DryRun {
 		return nil, currentDiff, nil
 	}
 
+	if existingEngine != nil && snapshot.ServingConfigs != nil {
+		if err := checkControlActionTypes(existingControls, snapshot.Controls); err != nil {
+			return nil, currentDiff, err
+		}
+	}
+
 	result := &SnapshotRestoreResult{
 		EngineName: opts.TargetEngineName,
 	}
@@ -305,9 +460,68 @@ func (c *GeminiClient) RestoreEngineSnapshot(snapshot *EngineSnapshot, opts Snap
 	}
 	result.AgentChanges = agentChanges
 
+	if snapshot.Assistant != nil {
+		changes, err := c.applyAssistantSnapshot(opts.TargetEngineName, existingAssistant, snapshot.Assistant, "assistant")
+		if err != nil {
+			return nil, currentDiff, fmt.Errorf("failed to apply assistant settings: %w", err)
+		}
+		result.AssistantChanges = changes
+	}
+
+	if len(snapshot.Assistants) > 0 {
+		assistantChanges, assistantAgentChanges, err := c.restoreAssistantSnapshots(opts.TargetEngineName, existingAssistants, snapshot.Assistants)
+		if err != nil {
+			return nil, currentDiff, fmt.Errorf("failed to restore assistants: %w", err)
+		}
+		result.AssistantChanges = append(result.AssistantChanges, assistantChanges...)
+		result.AgentChanges = append(result.AgentChanges, assistantAgentChanges...)
+	}
+
+	controlChanges, servingConfigChanges, err := c.restoreControlSnapshot(opts.TargetEngineName, snapshot)
+	result.ControlChanges = controlChanges
+	result.ServingConfigChanges = servingConfigChanges
+	if err != nil {
+		return nil, currentDiff, err
+	}
+
 	return result, currentDiff, nil
 }
 
+// getSnapshotAssistant returns the engine's default assistant, or nil when the
+// engine has none (for example search-only apps).
+func (c *GeminiClient) getSnapshotAssistant(engineName string) (*Assistant, error) {
+	assistant, err := c.GetAssistant(ConstructAssistantName(engineName, defaultAssistantID))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get assistant: %w", err)
+	}
+	return cloneAssistant(assistant), nil
+}
+
+func (c *GeminiClient) applyAssistantSnapshot(engineName string, current, desired *Assistant, prefix string) ([]FieldDiff, error) {
+	if current == nil {
+		existing, err := c.getSnapshotAssistant(engineName)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, fmt.Errorf("engine %s has no assistant", engineName)
+		}
+		current = existing
+	}
+
+	mask := DiffAssistantFields(current, desired)
+	if len(mask) == 0 {
+		return nil, nil
+	}
+	if _, err := c.UpdateAssistant(current.Name, desired, mask); err != nil {
+		return nil, err
+	}
+	return diffAssistant(current, desired, prefix), nil
+}
+
 func (c *GeminiClient) createEngineFromSnapshot(engineName string, cfg EngineConfigSnapshot) error {
 	engineID := extractResourceID(engineName)
 	parent := fmt.Sprintf("projects/%s/locations/%s/collections/%s",
@@ -410,69 +624,49 @@ func (c *GeminiClient) applyFeatureSnapshot(engineName string, desired map[strin
 	return err
 }
 
-func (c *GeminiClient) syncAgentsWithSnapshot(engineName string, current []*Agent, desired []*Agent) ([]AgentDiff, error) {
-	currentMap := make(map[string]*Agent)
-	for _, agent := range current {
-		currentMap[agentSnapshotKey(agent)] = agent
-	}
-
-	desiredMap := make(map[string]*Agent)
-	for _, agent := range desired {
-		desiredMap[agentSnapshotKey(agent)] = agent
-	}
-
-	changes := []AgentDiff{}
-
-	for key, desiredAgent := range desiredMap {
-		if existing, ok := currentMap[key]; ok {
-			updateMask, needsUpdate := diffAgentsFields(existing, desiredAgent)
-			if needsUpdate {
-				if err := c.updateAgentFromSnapshot(engineName, existing, desiredAgent, updateMask); err != nil {
-					return nil, err
-				}
-				changes = append(changes, AgentDiff{
-					Key:        key,
-					ChangeType: AgentDiffUpdated,
-					Old:        existing,
-					New:        desiredAgent,
-				})
-			}
-		} else {
-			if err := c.createAgentFromSnapshot(engineName, desiredAgent); err != nil {
-				return nil, err
-			}
-			changes = append(changes, AgentDiff{
-				Key:        key,
-				ChangeType: AgentDiffAdded,
-				New:        desiredAgent,
-			})
+// syncAgentsWithSnapshot makes the agents under parent, an engine or assistant
+// name, match desired.
+func (c *GeminiClient) syncAgentsWithSnapshot(parent string, current []*Agent, desired []*Agent) ([]AgentDiff, error) {
+	changes := diffAgents(current, desired)
+	for _, change := range changes {
+		if err := c.applyAgentDiff(parent, change); err != nil {
+			return nil, err
 		}
 	}
+	return changes, nil
+}
 
-	for key, existing := range currentMap {
-		if _, ok := desiredMap[key]; !ok {
-			if _, err := c.DeleteAgent(existing.Name); err != nil {
-				return nil, fmt.Errorf("failed to delete agent %s: %w", existing.Name, err)
-			}
-			changes = append(changes, AgentDiff{
-				Key:        key,
-				ChangeType: AgentDiffRemoved,
-				Old:        existing,
-			})
+// applyAgentDiff performs the create, update, or delete described by a single
+// agent diff. New agents are created under parent, an engine or assistant name.
+func (c *GeminiClient) applyAgentDiff(parent string, change AgentDiff) error {
+	switch change.ChangeType {
+	case AgentDiffAdded:
+		return c.createAgentFromSnapshot(parent, change.New)
+	case AgentDiffUpdated:
+		return c.updateAgentFromSnapshot(change.Old, change.New, change.UpdateMask)
+	case AgentDiffRemoved:
+		if _, err := c.DeleteAgent(change.Old.Name); err != nil {
+			return fmt.Errorf("failed to delete agent %s: %w", change.Old.Name, err)
 		}
+		return nil
+	default:
+		return fmt.Errorf("unknown agent change type %q", change.ChangeType)
 	}
-
-	return changes, nil
 }
 
-func (c *GeminiClient) createAgentFromSnapshot(engineName string, agent *Agent) error {
+func (c *GeminiClient) createAgentFromSnapshot(parent string, agent *Agent) error {
 	if agent == nil {
 		return nil
 	}
 	input := &AgentCreateInput{
-		DisplayName:     agent.DisplayName,
-		Description:     agent.Description,
-		ReasoningEngine: agent.ReasoningEngine,
+		DisplayName:               agent.DisplayName,
+		Description:               agent.Description,
+		ReasoningEngine:           agent.ReasoningEngine,
+		ConnectorDefinition:       cloneStringInterfaceMap(agent.ConnectorDefinition),
+		AdditionalAgentProperties: cloneStringInterfaceMap(agent.AdditionalAgentProperties),
+		Labels:                    cloneStringMap(agent.Labels),
+		Annotations:               cloneStringMap(agent.Annotations),
+		EnvironmentConfigurations: cloneEnvironmentConfigurations(agent.EnvironmentConfigurations),
 	}
 	if agent.Icon != nil {
 		input.Icon = &AgentIcon{
@@ -488,26 +682,37 @@ func (c *GeminiClient) createAgentFromSnapshot(engineName string, agent *Agent)
 			DialogflowAgent: agent.DialogflowAgentDefinition.DialogflowAgent,
 		}
 	}
+	if agent.AdkAgentDefinition != nil {
+		if agent.AdkReasoningEngine() == "" {
+			return fmt.Errorf("snapshot agent %s missing ADK reasoning engine resource", agent.DisplayName)
+		}
+		input.AdkAgentDefinition = cloneAdkAgentDefinition(agent.AdkAgentDefinition)
+	}
 	if agent.ReasoningEngine != "" {
 		input.ReasoningEngine = agent.ReasoningEngine
-	} else {
-		input.ReasoningEngine = engineName
+	} else if agent.AdkAgentDefinition == nil {
+		input.ReasoningEngine = engineFromAssistantName(parent)
 	}
-	_, err := c.CreateAgent(engineName, input)
+	_, err := c.CreateAgent(parent, input)
 	if err != nil {
 		return fmt.Errorf("failed to create agent %s: %w", agent.DisplayName, err)
 	}
 	return nil
 }
 
-func (c *GeminiClient) updateAgentFromSnapshot(engineName string, existing, desired *Agent, mask []string) error {
+func (c *GeminiClient) updateAgentFromSnapshot(existing, desired *Agent, mask []string) error {
 	if len(mask) == 0 {
 		return nil
 	}
 	input := &AgentUpdateInput{
-		DisplayName:     desired.DisplayName,
-		Description:     desired.Description,
-		ReasoningEngine: desired.ReasoningEngine,
+		DisplayName:               desired.DisplayName,
+		Description:               desired.Description,
+		ReasoningEngine:           desired.ReasoningEngine,
+		ConnectorDefinition:       cloneStringInterfaceMap(desired.ConnectorDefinition),
+		AdditionalAgentProperties: cloneStringInterfaceMap(desired.AdditionalAgentProperties),
+		Labels:                    cloneStringMap(desired.Labels),
+		Annotations:               cloneStringMap(desired.Annotations),
+		EnvironmentConfigurations: cloneEnvironmentConfigurations(desired.EnvironmentConfigurations),
 	}
 	if desired.Icon != nil {
 		input.Icon = &AgentIcon{
@@ -520,6 +725,9 @@ func (c *GeminiClient) updateAgentFromSnapshot(engineName string, existing, desi
 			DialogflowAgent: desired.DialogflowAgentDefinition.DialogflowAgent,
 		}
 	}
+	if desired.AdkAgentDefinition != nil {
+		input.AdkAgentDefinition = cloneAdkAgentDefinition(desired.AdkAgentDefinition)
+	}
 	_, err := c.UpdateAgent(existing.Name, input, mask)
 	if err != nil {
 		return fmt.Errorf("failed to update agent %s: %w", existing.Name, err)
@@ -567,6 +775,38 @@ func diffEngineConfig(a, b EngineConfigSnapshot) []FieldDiff {
 	return diffs
 }
 
+// diffAssistant reports changed assistant fields, prefixing field names with
+// prefix. Snapshots taken before assistants were captured carry no assistant
+// and produce no changes.
+func diffAssistant(a, b *Assistant, prefix string) []FieldDiff {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	mask := DiffAssistantFields(a, b)
+	if len(mask) == 0 {
+		return nil
+	}
+
+	oldFields := assistantFieldValues(a)
+	newFields := assistantFieldValues(b)
+	diffs := make([]FieldDiff, 0, len(mask))
+	for _, field := range mask {
+		diffs = append(diffs, FieldDiff{Field: prefix + "." + field, Old: oldFields[field], New: newFields[field]})
+	}
+	return diffs
+}
+
+func assistantFieldValues(assistant *Assistant) map[string]interface{} {
+	values := map[string]interface{}{}
+	data, err := json.Marshal(assistant)
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
 func diffFeatures(a, b map[string]string) []FeatureDiff {
 	diffs := []FeatureDiff{}
 	allKeys := map[string]struct{}{}
@@ -599,6 +839,13 @@ func diffFeatures(a, b map[string]string) []FeatureDiff {
 }
 
 func diffAgents(current, desired []*Agent) []AgentDiff {
+	return diffAgentList(current, desired, false)
+}
+
+// diffAgentList matches agents by key and records the update mask of each
+// changed agent. With declaredOnly, fields the desired agent leaves unset are
+// not compared, so they are never written.
+func diffAgentList(current, desired []*Agent, declaredOnly bool) []AgentDiff {
 	currentMap := make(map[string]*Agent)
 	for _, agent := range current {
 		currentMap[agentSnapshotKey(agent)] = agent
@@ -613,12 +860,13 @@ func diffAgents(current, desired []*Agent) []AgentDiff {
 
 	for key, desiredAgent := range desiredMap {
 		if existing, ok := currentMap[key]; ok {
-			if _, needsUpdate := diffAgentsFields(existing, desiredAgent); needsUpdate {
+			if updateMask, needsUpdate := diffAgentsFields(existing, desiredAgent, declaredOnly); needsUpdate {
 				changes = append(changes, AgentDiff{
 					Key:        key,
 					ChangeType: AgentDiffUpdated,
 					Old:        existing,
 					New:        desiredAgent,
+					UpdateMask: updateMask,
 				})
 			}
 		} else {
@@ -643,7 +891,9 @@ func diffAgents(current, desired []*Agent) []AgentDiff {
 	return changes
 }
 
-func diffAgentsFields(current, desired *Agent) ([]string, bool) {
+// diffAgentsFields returns the update mask that turns current into desired.
+// With declaredOnly, optional fields that desired leaves empty are skipped.
+func diffAgentsFields(current, desired *Agent, declaredOnly bool) ([]string, bool) {
 	if current == nil || desired == nil {
 		return nil, false
 	}
@@ -653,7 +903,7 @@ func diffAgentsFields(current, desired *Agent) ([]string, bool) {
 	if current.DisplayName != desired.DisplayName {
 		updateMask = append(updateMask, "displayName")
 	}
-	if current.Description != desired.Description {
+	if current.Description != desired.Description && (!declaredOnly || desired.Description != "") {
 		updateMask = append(updateMask, "description")
 	}
 	if current.ReasoningEngine != desired.ReasoningEngine && desired.ReasoningEngine != "" {
@@ -684,10 +934,35 @@ func diffAgentsFields(current, desired *Agent) ([]string, bool) {
 		desiredIconURI = desired.Icon.URI
 		desiredIconContent = desired.Icon.Content
 	}
-	if currentIconURI != desiredIconURI || currentIconContent != desiredIconContent {
+	if (currentIconURI != desiredIconURI || currentIconContent != desiredIconContent) && (!declaredOnly || desired.Icon != nil) {
 		updateMask = append(updateMask, "icon")
 	}
 
+	if desired.AdkAgentDefinition != nil && !jsonValuesEqual(current.AdkAgentDefinition, desired.AdkAgentDefinition) {
+		updateMask = append(updateMask, "adkAgentDefinition")
+	}
+
+	fields := []struct {
+		name     string
+		current  interface{}
+		desired  interface{}
+		declared bool
+	}{
+		{"connectorDefinition", current.ConnectorDefinition, desired.ConnectorDefinition, desired.ConnectorDefinition != nil},
+		{"additionalAgentProperties", current.AdditionalAgentProperties, desired.AdditionalAgentProperties, desired.AdditionalAgentProperties != nil},
+		{"labels", current.Labels, desired.Labels, desired.Labels != nil},
+		{"annotations", current.Annotations, desired.Annotations, desired.Annotations != nil},
+		{"environmentConfigurations", current.EnvironmentConfigurations, desired.EnvironmentConfigurations, desired.EnvironmentConfigurations != nil},
+	}
+	for _, field := range fields {
+		if declaredOnly && !field.declared {
+			continue
+		}
+		if !jsonValuesEqual(field.current, field.desired) {
+			updateMask = append(updateMask, field.name)
+		}
+	}
+
 	return updateMask, len(updateMask) > 0
 }
 
@@ -695,15 +970,23 @@ func (d SnapshotDiff) IsEmpty() bool {
 	return len(d.MetadataChanges) == 0 &&
 		len(d.EngineChanges) == 0 &&
 		len(d.FeatureChanges) == 0 &&
-		len(d.AgentChanges) == 0
+		len(d.AgentChanges) == 0 &&
+		len(d.AssistantChanges) == 0 &&
+		len(d.ConnectorChanges) == 0 &&
+		len(d.ControlChanges) == 0 &&
+		len(d.ServingConfigChanges) == 0
 }
 
 func agentSnapshotKey(agent *Agent) string {
 	if agent == nil {
 		return ""
 	}
+	kind := strings.ToLower(agent.Kind())
 	if agent.DialogflowAgentDefinition != nil && agent.DialogflowAgentDefinition.DialogflowAgent != "" {
-		return strings.ToLower(agent.DialogflowAgentDefinition.DialogflowAgent)
+		return kind + ":" + strings.ToLower(agent.DialogflowAgentDefinition.DialogflowAgent)
+	}
+	if engine := agent.AdkReasoningEngine(); engine != "" {
+		return kind + ":" + strings.ToLower(engine)
 	}
 	if agent.DisplayName != "" {
 		return strings.ToLower(agent.DisplayName)
@@ -726,6 +1009,12 @@ func cloneAgents(src []*Agent) []*Agent {
 			def := *agent.DialogflowAgentDefinition
 			copy.DialogflowAgentDefinition = &def
 		}
+		copy.AdkAgentDefinition = cloneAdkAgentDefinition(agent.AdkAgentDefinition)
+		copy.ConnectorDefinition = cloneStringInterfaceMap(agent.ConnectorDefinition)
+		copy.AdditionalAgentProperties = cloneStringInterfaceMap(agent.AdditionalAgentProperties)
+		copy.Labels = cloneStringMap(agent.Labels)
+		copy.Annotations = cloneStringMap(agent.Annotations)
+		copy.EnvironmentConfigurations = cloneEnvironmentConfigurations(agent.EnvironmentConfigurations)
 		cloned = append(cloned, &copy)
 	}
 	return cloned
@@ -753,6 +1042,53 @@ func cloneStringInterfaceMap(src map[string]interface{}) map[string]interface{}
 	return dst
 }
 
+func cloneAdkAgentDefinition(src *AdkAgentDefinition) *AdkAgentDefinition {
+	if src == nil {
+		return nil
+	}
+	dst := &AdkAgentDefinition{}
+	if src.ToolSettings != nil {
+		settings := *src.ToolSettings
+		dst.ToolSettings = &settings
+	}
+	if src.ProvisionedReasoningEngine != nil {
+		engine := *src.ProvisionedReasoningEngine
+		dst.ProvisionedReasoningEngine = &engine
+	}
+	if src.Authorizations != nil {
+		dst.Authorizations = append([]string{}, src.Authorizations...)
+	}
+	return dst
+}
+
+func cloneEnvironmentConfigurations(src []map[string]interface{}) []map[string]interface{} {
+	if src == nil {
+		return nil
+	}
+	dst := make([]map[string]interface{}, 0, len(src))
+	for _, cfg := range src {
+		dst = append(dst, cloneStringInterfaceMap(cfg))
+	}
+	return dst
+}
+
+// jsonValuesEqual compares two values by their JSON encoding, treating nil and
+// empty collections as equal so that omitted fields do not register as changes.
+func jsonValuesEqual(a, b interface{}) bool {
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		switch string(data) {
+		case "null", "{}", "[]":
+			return ""
+		}
+		return string(data)
+	}
+	return encode(a) == encode(b)
+}
+
 func stringSlicesEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -798,14 +1134,23 @@ func existingEngineFeatures(engine *Engine) map[string]string {
 }
 
 func isNotFound(err error) bool {
+	return httpStatusCode(err) == 404
+}
+
+// httpStatusCode extracts the HTTP status from API errors, or 0 if unknown.
+func httpStatusCode(err error) int {
 	if err == nil {
-		return false
+		return 0
 	}
 	var apiErr *googleapi.Error
 	if errors.As(err, &apiErr) {
-		return apiErr.Code == 404
+		return apiErr.Code
+	}
+	var statusErr *apiStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code
 	}
-	return false
+	return 0
 }
 
 func extractResourceID(resourceName string) string {
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AssistantSnapshot captures a non-default assistant and the agents registered to it.
+type AssistantSnapshot struct {
+	ID        string     `json:"id"`
+	Assistant *Assistant `json:"assistant,omitempty"`
+	Agents    []*Agent   `json:"agents,omitempty"`
+}
+
+// captureAdditionalAssistants snapshots every assistant other than the default
+// one. Engines without assistant support yield no assistants.
+func (c *GeminiClient) captureAdditionalAssistants(engineName string) ([]*AssistantSnapshot, error) {
+	assistants, err := c.ListAssistants(engineName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list assistants: %w", err)
+	}
+
+	snapshots := []*AssistantSnapshot{}
+	for _, assistant := range assistants {
+		if assistant == nil || assistant.IsDefaultAssistant() {
+			continue
+		}
+
+		agents, err := c.ListAgents(assistant.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list agents for assistant %s: %w", assistant.Name, err)
+		}
+
+		snapshots = append(snapshots, &AssistantSnapshot{
+			ID:        extractResourceID(assistant.Name),
+			Assistant: cloneAssistant(assistant),
+			Agents:    cloneAgents(agents),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ID < snapshots[j].ID
+	})
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	return snapshots, nil
+}
+
+// diffAssistantSnapshots compares additional assistants by ID. Agent change keys
+// are prefixed with the assistant ID so they can be told apart from agents on
+// the default assistant. With live set, b is the target engine and assistants
+// missing from a are skipped, matching restoreAssistantSnapshots.
+func diffAssistantSnapshots(a, b []*AssistantSnapshot, live bool) ([]FieldDiff, []AgentDiff) {
+	current := assistantSnapshotsByID(a)
+	desired := assistantSnapshotsByID(b)
+
+	ids := make([]string, 0, len(current)+len(desired))
+	for id := range current {
+		ids = append(ids, id)
+	}
+	for id := range desired {
+		if _, ok := current[id]; !ok && !live {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var fieldChanges []FieldDiff
+	var agentChanges []AgentDiff
+	for _, id := range ids {
+		from, inCurrent := current[id]
+		to, inDesired := desired[id]
+		field := "assistants/" + id
+
+		switch {
+		case !inCurrent:
+			fieldChanges = append(fieldChanges, FieldDiff{Field: field, Old: "missing", New: "present"})
+			agentChanges = append(agentChanges, prefixAgentDiffs(id, diffAgents(nil, to.Agents))...)
+		case !inDesired:
+			fieldChanges = append(fieldChanges, FieldDiff{Field: field, Old: "present", New: "missing"})
+			agentChanges = append(agentChanges, prefixAgentDiffs(id, diffAgents(from.Agents, nil))...)
+		default:
+			fieldChanges = append(fieldChanges, diffAssistant(from.Assistant, to.Assistant, field)...)
+			agentChanges = append(agentChanges, prefixAgentDiffs(id, diffAgents(from.Agents, to.Agents))...)
+		}
+	}
+	return fieldChanges, agentChanges
+}
+
+// restoreAssistantSnapshots creates missing assistants, updates their settings,
+// and syncs their agents. Assistants on the target that are not in the snapshot
+// are left untouched.
+func (c *GeminiClient) restoreAssistantSnapshots(engineName string, current, desired []*AssistantSnapshot) ([]FieldDiff, []AgentDiff, error) {
+	existing := assistantSnapshotsByID(current)
+
+	var fieldChanges []FieldDiff
+	var agentChanges []AgentDiff
+	for _, snapshot := range desired {
+		if snapshot == nil || snapshot.ID == "" {
+			continue
+		}
+		field := "assistants/" + snapshot.ID
+		assistantName := ConstructAssistantName(engineName, snapshot.ID)
+
+		var currentAgents []*Agent
+		if target, ok := existing[snapshot.ID]; ok {
+			currentAgents = target.Agents
+			if snapshot.Assistant != nil {
+				targetAssistant := target.Assistant
+				if targetAssistant == nil {
+					targetAssistant = &Assistant{Name: assistantName}
+				}
+				changes, err := c.applyAssistantSnapshot(engineName, targetAssistant, snapshot.Assistant, field)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to update assistant %s: %w", snapshot.ID, err)
+				}
+				fieldChanges = append(fieldChanges, changes...)
+			}
+		} else {
+			if _, err := c.CreateAssistant(engineName, snapshot.ID, snapshot.Assistant); err != nil {
+				return nil, nil, fmt.Errorf("failed to create assistant %s: %w", snapshot.ID, err)
+			}
+			fieldChanges = append(fieldChanges, FieldDiff{Field: field, Old: "missing", New: "created"})
+		}
+
+		changes, err := c.syncAgentsWithSnapshot(assistantName, currentAgents, snapshot.Agents)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to sync agents for assistant %s: %w", snapshot.ID, err)
+		}
+		agentChanges = append(agentChanges, prefixAgentDiffs(snapshot.ID, changes)...)
+	}
+	return fieldChanges, agentChanges, nil
+}
+
+func assistantSnapshotsByID(snapshots []*AssistantSnapshot) map[string]*AssistantSnapshot {
+	byID := make(map[string]*AssistantSnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot == nil || snapshot.ID == "" {
+			continue
+		}
+		byID[snapshot.ID] = snapshot
+	}
+	return byID
+}
+
+func prefixAgentDiffs(assistantID string, changes []AgentDiff) []AgentDiff {
+	for i := range changes {
+		changes[i].Key = assistantID + "/" + changes[i].Key
+	}
+	return changes
+}
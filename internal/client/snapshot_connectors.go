@@ -0,0 +1,115 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// captureEngineConnectors returns the data connectors that sync into any of
+// the engine's data stores, with credentials stripped. Connectors are captured
+// for reference only; restore does not recreate them because their
+// credentials are not part of the snapshot.
+func (c *GeminiClient) captureEngineConnectors(dataStoreIDs []string) ([]*DataConnector, error) {
+	if len(dataStoreIDs) == 0 {
+		return []*DataConnector{}, nil
+	}
+
+	connectors, err := c.ListDataConnectors()
+	if err != nil {
+		if isNotFound(err) {
+			return []*DataConnector{}, nil
+		}
+		return nil, fmt.Errorf("failed to list data connectors: %w", err)
+	}
+
+	engineDataStores := map[string]bool{}
+	for _, id := range dataStoreIDs {
+		engineDataStores[id] = true
+	}
+
+	captured := []*DataConnector{}
+	for _, connector := range connectors {
+		for _, id := range connector.ConnectorDataStoreIDs() {
+			if engineDataStores[id] {
+				captured = append(captured, sanitizeConnector(connector))
+				break
+			}
+		}
+	}
+	return captured, nil
+}
+
+// setConnectors records the result of captureEngineConnectors. A failed
+// capture, such as a permission error for users with only engine-level roles,
+// leaves the snapshot without connectors and adds a warning instead of
+// failing the snapshot.
+func (s *EngineSnapshot) setConnectors(connectors []*DataConnector, err error) {
+	if err != nil {
+		s.Warnings = append(s.Warnings, fmt.Sprintf("data connectors were not captured: %v", err))
+		return
+	}
+	s.Connectors = connectors
+	s.ConnectorsCaptured = true
+}
+
+// diffConnectors reports connectors added, removed, or changed between two
+// connector lists, keyed by collection name. Callers skip snapshots that did
+// not capture connectors.
+func diffConnectors(a, b []*DataConnector) []FieldDiff {
+	oldByName := connectorsByCollection(a)
+	newByName := connectorsByCollection(b)
+
+	keys := []string{}
+	for key := range oldByName {
+		keys = append(keys, key)
+	}
+	for key := range newByName {
+		if _, ok := oldByName[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	diffs := []FieldDiff{}
+	for _, key := range keys {
+		oldConnector, newConnector := oldByName[key], newByName[key]
+		switch {
+		case oldConnector == nil:
+			diffs = append(diffs, FieldDiff{Field: "connector." + key, New: newConnector.DataSource})
+		case newConnector == nil:
+			diffs = append(diffs, FieldDiff{Field: "connector." + key, Old: oldConnector.DataSource})
+		default:
+			oldFields := connectorFieldValues(oldConnector)
+			newFields := connectorFieldValues(newConnector)
+			for _, field := range []string{"dataSource", "syncMode", "refreshInterval", "incrementalRefreshInterval", "autoRunDisabled", "aclEnabled", "params", "entities"} {
+				if !reflect.DeepEqual(oldFields[field], newFields[field]) {
+					diffs = append(diffs, FieldDiff{Field: "connector." + key + "." + field, Old: oldFields[field], New: newFields[field]})
+				}
+			}
+		}
+	}
+	return diffs
+}
+
+func connectorsByCollection(connectors []*DataConnector) map[string]*DataConnector {
+	byName := map[string]*DataConnector{}
+	for _, connector := range connectors {
+		if connector == nil {
+			continue
+		}
+		byName[extractResourceID(connector.CollectionName())] = connector
+	}
+	return byName
+}
+
+func connectorFieldValues(connector *DataConnector) map[string]interface{} {
+	values := map[string]interface{}{}
+	data, err := json.Marshal(sanitizeConnector(connector))
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(data, &values)
+	return values
+}
@@ -0,0 +1,88 @@
+package client
+
+import "fmt"
+
+// captureControls returns the engine's controls.
+func (c *GeminiClient) captureControls(engineName string) ([]*Control, error) {
+	controls, err := c.ListControls(engineName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list controls: %w", err)
+	}
+
+	captured := make([]*Control, 0, len(controls))
+	for _, control := range controls {
+		clone := cloneControl(control)
+		clone.AssociatedServingConfigIds = nil
+		captured = append(captured, clone)
+	}
+	return captured, nil
+}
+
+// captureServingConfigs returns the engine's serving configs with output-only
+// timestamps cleared.
+func (c *GeminiClient) captureServingConfigs(engineName string) ([]*ServingConfig, error) {
+	servingConfigs, err := c.ListServingConfigs(engineName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list serving configs: %w", err)
+	}
+
+	captured := make([]*ServingConfig, 0, len(servingConfigs))
+	for _, servingConfig := range servingConfigs {
+		clone := *servingConfig
+		clone.CreateTime = ""
+		clone.UpdateTime = ""
+		captured = append(captured, &clone)
+	}
+	return captured, nil
+}
+
+// diffSnapshotControls compares controls between snapshots. Snapshots taken
+// before controls were captured carry no serving configs and produce no changes.
+func diffSnapshotControls(a, b *EngineSnapshot) []FieldDiff {
+	if a.ServingConfigs == nil || b.ServingConfigs == nil {
+		return nil
+	}
+	return DiffControls(a.Controls, b.Controls, true)
+}
+
+// restoreControlSnapshot syncs controls and serving configs with the snapshot.
+// Controls are created and updated first so serving configs can link them,
+// and controls missing from the snapshot are deleted last, once no serving
+// config references them.
+func (c *GeminiClient) restoreControlSnapshot(engineName string, snapshot *EngineSnapshot) ([]FieldDiff, []FieldDiff, error) {
+	if snapshot.ServingConfigs == nil {
+		return nil, nil, nil
+	}
+
+	currentControls, err := c.captureControls(engineName)
+	if err != nil {
+		return nil, nil, err
+	}
+	controlChanges, err := c.SyncControls(engineName, currentControls, snapshot.Controls)
+	if err != nil {
+		return controlChanges, nil, fmt.Errorf("failed to sync controls: %w", err)
+	}
+
+	currentServingConfigs, err := c.captureServingConfigs(engineName)
+	if err != nil {
+		return controlChanges, nil, err
+	}
+	servingConfigChanges, err := c.applyServingConfigSnapshots(engineName, currentServingConfigs, snapshot.ServingConfigs)
+	if err != nil {
+		return controlChanges, servingConfigChanges, fmt.Errorf("failed to update serving configs: %w", err)
+	}
+
+	pruned, err := c.PruneControls(engineName, currentControls, snapshot.Controls)
+	controlChanges = append(controlChanges, pruned...)
+	if err != nil {
+		return controlChanges, servingConfigChanges, fmt.Errorf("failed to delete controls: %w", err)
+	}
+
+	return controlChanges, servingConfigChanges, nil
+}
@@ -0,0 +1,449 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	snapshotDigestAlgorithm = "sha256"
+
+	// SnapshotSignatureEd25519 identifies signatures produced with an Ed25519 private key.
+	SnapshotSignatureEd25519 = "ed25519"
+	// SnapshotSignatureHMACSHA256 identifies signatures produced with a shared HMAC secret.
+	SnapshotSignatureHMACSHA256 = "hmac-sha256"
+
+	defaultRedactionReplacement = "REDACTED"
+)
+
+// SnapshotIntegrity carries the content digest and optional signature of a snapshot.
+type SnapshotIntegrity struct {
+	Algorithm string             `json:"algorithm"`
+	Digest    string             `json:"digest"`
+	Signature *SnapshotSignature `json:"signature,omitempty"`
+}
+
+// SnapshotSignature is a detached signature over the snapshot digest.
+type SnapshotSignature struct {
+	Algorithm string `json:"algorithm"`
+	KeyID     string `json:"keyId,omitempty"`
+	Value     string `json:"value"`
+}
+
+// SnapshotSigner signs snapshot digests. Implementations may wrap local keys or remote KMS services.
+type SnapshotSigner interface {
+	Algorithm() string
+	KeyID() string
+	Sign(digest []byte) ([]byte, error)
+}
+
+// SnapshotVerifier verifies signatures produced by a SnapshotSigner.
+type SnapshotVerifier interface {
+	Algorithm() string
+	Verify(digest, signature []byte) error
+}
+
+// SnapshotRedactionRule describes an agent field to scrub before a snapshot is shared.
+// Field is a dot-separated path relative to each agent (e.g. metadata.apiKey or
+// additionalAgentProperties.*). A "*" segment matches every key at that level.
+type SnapshotRedactionRule struct {
+	Field       string `json:"field" yaml:"field"`
+	Replacement string `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+	Remove      bool   `json:"remove,omitempty" yaml:"remove,omitempty"`
+}
+
+// SnapshotRedactionConfig groups redaction rules loaded from a rules file.
+type SnapshotRedactionConfig struct {
+	Rules []SnapshotRedactionRule `json:"rules" yaml:"rules"`
+}
+
+// ComputeDigest returns the hex-encoded SHA-256 digest of the snapshot content,
+// excluding the integrity block itself.
+func (s *EngineSnapshot) ComputeDigest() (string, error) {
+	if s == nil {
+		return "", fmt.Errorf("snapshot is nil")
+	}
+	clone := *s
+	clone.Integrity = nil
+	data, err := json.Marshal(&clone)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode snapshot for digest: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Seal computes the snapshot digest and, when a signer is provided, signs it.
+// Any change to the snapshot after sealing invalidates the integrity block.
+func (s *EngineSnapshot) Seal(signer SnapshotSigner) error {
+	digest, err := s.ComputeDigest()
+	if err != nil {
+		return err
+	}
+
+	integrity := &SnapshotIntegrity{
+		Algorithm: snapshotDigestAlgorithm,
+		Digest:    digest,
+	}
+
+	if signer != nil {
+		raw, err := hex.DecodeString(digest)
+		if err != nil {
+			return fmt.Errorf("failed to decode digest: %w", err)
+		}
+		sig, err := signer.Sign(raw)
+		if err != nil {
+			return fmt.Errorf("failed to sign snapshot: %w", err)
+		}
+		integrity.Signature = &SnapshotSignature{
+			Algorithm: signer.Algorithm(),
+			KeyID:     signer.KeyID(),
+			Value:     base64.StdEncoding.EncodeToString(sig),
+		}
+	}
+
+	s.Integrity = integrity
+	return nil
+}
+
+// VerifyIntegrity checks the snapshot digest and, when a verifier is provided, its signature.
+// Snapshots without an integrity block are rejected only when requireIntegrity is set.
+func (s *EngineSnapshot) VerifyIntegrity(verifier SnapshotVerifier, requireIntegrity bool) error {
+	if s == nil {
+		return fmt.Errorf("snapshot is nil")
+	}
+
+	if s.Integrity == nil {
+		if requireIntegrity || verifier != nil {
+			return fmt.Errorf("snapshot has no integrity digest")
+		}
+		return nil
+	}
+
+	if s.Integrity.Algorithm != snapshotDigestAlgorithm {
+		return fmt.Errorf("unsupported snapshot digest algorithm %q", s.Integrity.Algorithm)
+	}
+
+	digest, err := s.ComputeDigest()
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(digest), []byte(strings.ToLower(s.Integrity.Digest))) {
+		return fmt.Errorf("snapshot digest mismatch: content has been modified since it was sealed")
+	}
+
+	if verifier == nil {
+		return nil
+	}
+
+	sig := s.Integrity.Signature
+	if sig == nil {
+		return fmt.Errorf("snapshot is not signed")
+	}
+	if sig.Algorithm != verifier.Algorithm() {
+		return fmt.Errorf("snapshot signed with %s but verification key is %s", sig.Algorithm, verifier.Algorithm())
+	}
+
+	rawSig, err := base64.StdEncoding.DecodeString(sig.Value)
+	if err != nil {
+		return fmt.Errorf("failed to decode snapshot signature: %w", err)
+	}
+	rawDigest, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("failed to decode digest: %w", err)
+	}
+	if err := verifier.Verify(rawDigest, rawSig); err != nil {
+		return fmt.Errorf("snapshot signature verification failed: %w", err)
+	}
+	return nil
+}
+
+// Redact applies redaction rules to every agent in the snapshot and marks the
+// snapshot metadata as redacted. Any existing integrity block is dropped.
+func (s *EngineSnapshot) Redact(rules []SnapshotRedactionRule) error {
+	if s == nil {
+		return fmt.Errorf("snapshot is nil")
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for i, agent := range s.Agents {
+		redacted, err := redactAgent(agent, rules)
+		if err != nil {
+			return err
+		}
+		s.Agents[i] = redacted
+	}
+	for _, assistant := range s.Assistants {
+		if assistant == nil {
+			continue
+		}
+		for i, agent := range assistant.Agents {
+			redacted, err := redactAgent(agent, rules)
+			if err != nil {
+				return err
+			}
+			assistant.Agents[i] = redacted
+		}
+	}
+
+	s.Metadata.Redacted = true
+	s.Integrity = nil
+	return nil
+}
+
+// LoadSnapshotRedactionConfig reads redaction rules from a YAML or JSON file.
+func LoadSnapshotRedactionConfig(path string) (*SnapshotRedactionConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redaction rules %s: %w", path, err)
+	}
+
+	var cfg SnapshotRedactionConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse redaction rules: %w", err)
+	}
+
+	for i, rule := range cfg.Rules {
+		if strings.TrimSpace(rule.Field) == "" {
+			return nil, fmt.Errorf("redaction rule %d is missing a field path", i+1)
+		}
+	}
+	return &cfg, nil
+}
+
+// LoadSnapshotSigner builds a signer from a key file. PEM-encoded Ed25519 private
+// keys produce Ed25519 signatures; any other content is treated as an HMAC secret.
+func LoadSnapshotSigner(path string) (SnapshotSigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key %s: %w", path, err)
+	}
+
+	if block, _ := pem.Decode(data); block != nil {
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing key: %w", err)
+		}
+		privateKey, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("signing key must be an Ed25519 private key")
+		}
+		return &ed25519SnapshotSigner{key: privateKey}, nil
+	}
+
+	secret := []byte(strings.TrimSpace(string(data)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("signing key %s is empty", path)
+	}
+	return &hmacSnapshotSigner{secret: secret}, nil
+}
+
+// LoadSnapshotVerifier builds a verifier from a key file. PEM-encoded Ed25519 public
+// or private keys verify Ed25519 signatures; any other content is treated as an HMAC secret.
+func LoadSnapshotVerifier(path string) (SnapshotVerifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read verification key %s: %w", path, err)
+	}
+
+	if block, _ := pem.Decode(data); block != nil {
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse verification key: %w", err)
+			}
+			publicKey, ok := key.(ed25519.PublicKey)
+			if !ok {
+				return nil, fmt.Errorf("verification key must be an Ed25519 public key")
+			}
+			return &ed25519SnapshotSigner{public: publicKey}, nil
+		default:
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse verification key: %w", err)
+			}
+			privateKey, ok := key.(ed25519.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("verification key must be an Ed25519 key")
+			}
+			return &ed25519SnapshotSigner{key: privateKey}, nil
+		}
+	}
+
+	secret := []byte(strings.TrimSpace(string(data)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("verification key %s is empty", path)
+	}
+	return &hmacSnapshotSigner{secret: secret}, nil
+}
+
+type ed25519SnapshotSigner struct {
+	key    ed25519.PrivateKey
+	public ed25519.PublicKey
+}
+
+func (s *ed25519SnapshotSigner) Algorithm() string {
+	return SnapshotSignatureEd25519
+}
+
+func (s *ed25519SnapshotSigner) KeyID() string {
+	sum := sha256.Sum256(s.publicKey())
+	return hex.EncodeToString(sum[:8])
+}
+
+func (s *ed25519SnapshotSigner) Sign(digest []byte) ([]byte, error) {
+	if s.key == nil {
+		return nil, fmt.Errorf("ed25519 private key is required for signing")
+	}
+	return ed25519.Sign(s.key, digest), nil
+}
+
+func (s *ed25519SnapshotSigner) Verify(digest, signature []byte) error {
+	if !ed25519.Verify(s.publicKey(), digest, signature) {
+		return fmt.Errorf("invalid ed25519 signature")
+	}
+	return nil
+}
+
+func (s *ed25519SnapshotSigner) publicKey() ed25519.PublicKey {
+	if s.public != nil {
+		return s.public
+	}
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+type hmacSnapshotSigner struct {
+	secret []byte
+}
+
+func (s *hmacSnapshotSigner) Algorithm() string {
+	return SnapshotSignatureHMACSHA256
+}
+
+func (s *hmacSnapshotSigner) KeyID() string {
+	sum := sha256.Sum256(s.secret)
+	return hex.EncodeToString(sum[:8])
+}
+
+func (s *hmacSnapshotSigner) Sign(digest []byte) ([]byte, error) {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(digest)
+	return mac.Sum(nil), nil
+}
+
+func (s *hmacSnapshotSigner) Verify(digest, signature []byte) error {
+	expected, _ := s.Sign(digest)
+	if !hmac.Equal(expected, signature) {
+		return fmt.Errorf("invalid hmac signature")
+	}
+	return nil
+}
+
+func redactAgent(agent *Agent, rules []SnapshotRedactionRule) (*Agent, error) {
+	if agent == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(agent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode agent for redaction: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode agent for redaction: %w", err)
+	}
+
+	for _, rule := range rules {
+		replacement := rule.Replacement
+		if replacement == "" {
+			replacement = defaultRedactionReplacement
+		}
+		redactPath(fields, strings.Split(strings.TrimSpace(rule.Field), "."), replacement, rule.Remove)
+	}
+
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode redacted agent: %w", err)
+	}
+	var redacted Agent
+	if err := json.Unmarshal(data, &redacted); err != nil {
+		return nil, fmt.Errorf("failed to decode redacted agent %s: %w", agent.DisplayName, err)
+	}
+	return &redacted, nil
+}
+
+func redactPath(node map[string]interface{}, path []string, replacement string, remove bool) {
+	if len(path) == 0 || node == nil {
+		return
+	}
+
+	segment := path[0]
+	keys := []string{segment}
+	if segment == "*" {
+		keys = keys[:0]
+		for key := range node {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		value, ok := node[key]
+		if !ok {
+			continue
+		}
+		if len(path) == 1 {
+			if remove {
+				delete(node, key)
+			} else {
+				node[key] = redactValue(value, replacement)
+			}
+			continue
+		}
+		switch child := value.(type) {
+		case map[string]interface{}:
+			redactPath(child, path[1:], replacement, remove)
+		case []interface{}:
+			for _, item := range child {
+				if m, ok := item.(map[string]interface{}); ok {
+					redactPath(m, path[1:], replacement, remove)
+				}
+			}
+		}
+	}
+}
+
+// redactValue replaces every string leaf with the replacement string so that
+// typed agent fields (e.g. icon) keep their shape after redaction. Bools,
+// numbers and nulls are left unchanged, since a string cannot decode into them.
+func redactValue(value interface{}, replacement string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = redactValue(child, replacement)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(child, replacement)
+		}
+		return v
+	case string:
+		return replacement
+	default:
+		return v
+	}
+}
@@ -0,0 +1,335 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/discoveryengine/v1"
+)
+
+const (
+	defaultUserEventConcurrency = 4
+	defaultUserEventBatchSize   = 1000
+	maxUserEventLineBytes       = 1024 * 1024
+	maxUserPseudoIDLength       = 128
+)
+
+// userEventTypes lists the event types accepted by the user event API.
+var userEventTypes = map[string]bool{
+	"search":             true,
+	"view-item":          true,
+	"view-item-list":     true,
+	"view-home-page":     true,
+	"view-category-page": true,
+	"add-to-cart":        true,
+	"purchase":           true,
+	"media-play":         true,
+	"media-complete":     true,
+	"conversion":         true,
+}
+
+// userEventTypesWithDocuments lists the event types that must reference documents.
+var userEventTypesWithDocuments = map[string]bool{
+	"view-item":      true,
+	"add-to-cart":    true,
+	"purchase":       true,
+	"media-play":     true,
+	"media-complete": true,
+}
+
+// UserEventRecord is a user event read from a JSONL source. Err is set, and
+// Event is nil, when the line could not be parsed as a user event.
+type UserEventRecord struct {
+	Line  int
+	Event *discoveryengine.GoogleCloudDiscoveryengineV1UserEvent
+	Err   error
+}
+
+// UserEventResult reports the outcome for a single user event.
+type UserEventResult struct {
+	Line         int    `json:"line"`
+	EventType    string `json:"eventType"`
+	UserPseudoID string `json:"userPseudoId"`
+	Status       string `json:"status"`
+	Error        string `json:"error,omitempty"`
+}
+
+// UserEventImportResult reports the outcome of an inline or GCS import.
+type UserEventImportResult struct {
+	Operations    []string          `json:"operations"`
+	ImportedCount int64             `json:"importedCount"`
+	FailedCount   int64             `json:"failedCount"`
+	JoinedCount   int64             `json:"joinedCount,omitempty"`
+	UnjoinedCount int64             `json:"unjoinedCount,omitempty"`
+	ErrorSamples  []string          `json:"errorSamples,omitempty"`
+	Invalid       []UserEventResult `json:"invalid,omitempty"`
+}
+
+// UserEventPurgeResult reports the outcome of a user event purge.
+type UserEventPurgeResult struct {
+	Operation   string `json:"operation"`
+	Filter      string `json:"filter"`
+	PurgedCount int64  `json:"purgedCount"`
+}
+
+// ReadUserEvents parses one JSON user event per line from r. Blank lines are
+// skipped, and malformed lines are returned as records with Err set so they
+// are reported with the other invalid events; source is used in error messages.
+func ReadUserEvents(r io.Reader, source string) ([]UserEventRecord, error) {
+	records := []UserEventRecord{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxUserEventLineBytes)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var event discoveryengine.GoogleCloudDiscoveryengineV1UserEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			records = append(records, UserEventRecord{Line: lineNumber, Err: fmt.Errorf("invalid JSON: %w", err)})
+			continue
+		}
+		records = append(records, UserEventRecord{Line: lineNumber, Event: &event})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", source, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no user events found in %s", source)
+	}
+	return records, nil
+}
+
+// ValidateUserEvent checks the event type and the fields the API requires for
+// it. Imports additionally require eventTime.
+func ValidateUserEvent(event *discoveryengine.GoogleCloudDiscoveryengineV1UserEvent, requireEventTime bool) error {
+	if event.EventType == "" {
+		return fmt.Errorf("eventType is required")
+	}
+	if !userEventTypes[event.EventType] {
+		return fmt.Errorf("unsupported eventType %q", event.EventType)
+	}
+
+	if event.UserPseudoId == "" {
+		return fmt.Errorf("userPseudoId is required")
+	}
+	if len(event.UserPseudoId) > maxUserPseudoIDLength {
+		return fmt.Errorf("userPseudoId exceeds %d characters", maxUserPseudoIDLength)
+	}
+
+	if event.EventTime == "" {
+		if requireEventTime {
+			return fmt.Errorf("eventTime is required for imports")
+		}
+	} else if _, err := time.Parse(time.RFC3339Nano, event.EventTime); err != nil {
+		return fmt.Errorf("eventTime %q is not an RFC 3339 timestamp", event.EventTime)
+	}
+
+	if event.EventType == "conversion" && event.ConversionType == "" {
+		return fmt.Errorf("conversionType is required for conversion events")
+	}
+	if event.EventType != "conversion" && event.ConversionType != "" {
+		return fmt.Errorf("conversionType is only allowed on conversion events")
+	}
+
+	if event.EventType == "search" {
+		if (event.SearchInfo == nil || event.SearchInfo.SearchQuery == "") && event.Filter == "" {
+			return fmt.Errorf("search events require searchInfo.searchQuery or filter")
+		}
+	}
+	if event.EventType == "view-category-page" {
+		if event.PageInfo == nil || event.PageInfo.PageCategory == "" {
+			return fmt.Errorf("view-category-page events require pageInfo.pageCategory")
+		}
+	}
+
+	if userEventTypesWithDocuments[event.EventType] && len(event.Documents) == 0 {
+		return fmt.Errorf("%s events require at least one document", event.EventType)
+	}
+	for i, document := range event.Documents {
+		if document == nil || (document.Id == "" && document.Name == "" && document.Uri == "") {
+			return fmt.Errorf("documents[%d] requires id, name, or uri", i)
+		}
+	}
+	return nil
+}
+
+// ValidateUserEvents validates records and splits them into valid records and
+// results for the invalid ones.
+func ValidateUserEvents(records []UserEventRecord, requireEventTime bool) ([]UserEventRecord, []UserEventResult) {
+	valid := []UserEventRecord{}
+	invalid := []UserEventResult{}
+	for _, record := range records {
+		err := record.Err
+		if err == nil {
+			err = ValidateUserEvent(record.Event, requireEventTime)
+		}
+		if err != nil {
+			result := newUserEventResult(record, "invalid")
+			result.Error = err.Error()
+			invalid = append(invalid, result)
+			continue
+		}
+		valid = append(valid, record)
+	}
+	return valid, invalid
+}
+
+// WriteUserEvents writes events one by one with up to concurrency requests in
+// flight and reports the result of each. Failures do not stop the remaining
+// writes.
+func (c *GeminiClient) WriteUserEvents(dataStoreName string, records []UserEventRecord, concurrency int) []UserEventResult {
+	if concurrency <= 0 {
+		concurrency = defaultUserEventConcurrency
+	}
+
+	results := make([]UserEventResult, len(records))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	// The slot is taken before starting the goroutine so a large backfill
+	// never has more than concurrency goroutines alive.
+	for i, record := range records {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, record UserEventRecord) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result := newUserEventResult(record, "success")
+			_, err := c.service.Projects.Locations.Collections.DataStores.UserEvents.
+				Write(dataStoreName, record.Event).Do()
+			if err != nil {
+				result.Status = "error"
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, record)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// ImportUserEvents imports events inline in batches of batchSize and waits
+// for each batch to finish.
+func (c *GeminiClient) ImportUserEvents(dataStoreName string, records []UserEventRecord, batchSize int) (*UserEventImportResult, error) {
+	if batchSize <= 0 {
+		batchSize = defaultUserEventBatchSize
+	}
+
+	result := &UserEventImportResult{Operations: []string{}}
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		inline := &discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsRequestInlineSource{}
+		for _, record := range records[start:end] {
+			inline.UserEvents = append(inline.UserEvents, record.Event)
+		}
+
+		request := &discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsRequest{InlineSource: inline}
+		if err := c.runUserEventImport(dataStoreName, request, result); err != nil {
+			return result, fmt.Errorf("failed to import user events %d-%d: %w", start+1, end, err)
+		}
+	}
+	return result, nil
+}
+
+// ImportUserEventsFromGCS imports JSONL user events from Cloud Storage.
+func (c *GeminiClient) ImportUserEventsFromGCS(dataStoreName, gcsURI, errorPrefix string) (*UserEventImportResult, error) {
+	request := &discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsRequest{
+		GcsSource: &discoveryengine.GoogleCloudDiscoveryengineV1GcsSource{
+			InputUris:  []string{gcsURI},
+			DataSchema: "user_event",
+		},
+	}
+	if errorPrefix != "" {
+		request.ErrorConfig = &discoveryengine.GoogleCloudDiscoveryengineV1ImportErrorConfig{GcsPrefix: errorPrefix}
+	}
+
+	result := &UserEventImportResult{Operations: []string{}}
+	if err := c.runUserEventImport(dataStoreName, request, result); err != nil {
+		return result, fmt.Errorf("failed to import user events from %s: %w", gcsURI, err)
+	}
+	return result, nil
+}
+
+// PurgeUserEvents permanently deletes the events matching filter.
+func (c *GeminiClient) PurgeUserEvents(dataStoreName, filter string) (*UserEventPurgeResult, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, fmt.Errorf("a purge filter is required")
+	}
+
+	operation, err := c.service.Projects.Locations.Collections.DataStores.UserEvents.
+		Purge(dataStoreName, &discoveryengine.GoogleCloudDiscoveryengineV1PurgeUserEventsRequest{Filter: filter, Force: true}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge user events: %w", err)
+	}
+
+	status, err := c.WaitForOperation(operation.Name, 0, 30*time.Minute, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserEventPurgeResult{Operation: operation.Name, Filter: filter}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1alphaPurgeUserEventsResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.PurgedCount = response.PurgeCount
+	}
+	return result, nil
+}
+
+// SortUserEventResults orders results by source line.
+func SortUserEventResults(results []UserEventResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Line < results[j].Line
+	})
+}
+
+func (c *GeminiClient) runUserEventImport(dataStoreName string, request *discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsRequest, result *UserEventImportResult) error {
+	operation, err := c.service.Projects.Locations.Collections.DataStores.UserEvents.
+		Import(dataStoreName, request).Do()
+	if err != nil {
+		return err
+	}
+	result.Operations = append(result.Operations, operation.Name)
+
+	status, err := c.WaitForOperation(operation.Name, 0, 30*time.Minute, nil)
+	if err != nil {
+		return err
+	}
+
+	var metadata discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsMetadata
+	if err := status.DecodeMetadata(&metadata); err == nil {
+		result.ImportedCount += metadata.SuccessCount
+		result.FailedCount += metadata.FailureCount
+	}
+	var response discoveryengine.GoogleCloudDiscoveryengineV1ImportUserEventsResponse
+	if err := status.DecodeResponse(&response); err == nil {
+		result.JoinedCount += response.JoinedEventsCount
+		result.UnjoinedCount += response.UnjoinedEventsCount
+		result.ErrorSamples = append(result.ErrorSamples, rpcStatusMessages(response.ErrorSamples)...)
+	}
+	return nil
+}
+
+func newUserEventResult(record UserEventRecord, status string) UserEventResult {
+	result := UserEventResult{Line: record.Line, Status: status}
+	if record.Event != nil {
+		result.EventType = record.Event.EventType
+		result.UserPseudoID = record.Event.UserPseudoId
+	}
+	return result
+}
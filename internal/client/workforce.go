@@ -9,8 +9,13 @@ import (
 )
 
 const (
-	idpTypeThirdParty        = "THIRD_PARTY"
-	idpTypeUnspecified       = "IDP_TYPE_UNSPECIFIED"
+	// IdpTypeGSuite uses Google identities (Cloud Identity / Google Workspace).
+	IdpTypeGSuite = "GSUITE"
+	// IdpTypeThirdParty uses a third-party IdP federated through a workforce pool.
+	IdpTypeThirdParty = "THIRD_PARTY"
+	// IdpTypeUnspecified leaves the identity provider unconfigured.
+	IdpTypeUnspecified = "IDP_TYPE_UNSPECIFIED"
+
 	defaultWorkforceLocation = "locations/global"
 )
 
@@ -49,29 +54,28 @@ func (c *GeminiClient) GetWorkforceIdentityConfig() (*WorkforceIdentityConfig, e
 	return result, nil
 }
 
-// SetWorkforceIdentityConfig updates the workforce identity pool configuration.
-// The resource string should follow locations/{location}/workforcePools/{pool}[/providers/{provider}].
-// Passing an empty string disables workforce identity.
-func (c *GeminiClient) SetWorkforceIdentityConfig(workforceResource string) (*WorkforceIdentityConfig, error) {
+// SetWorkforceIdentityConfig updates the identity provider configuration.
+// THIRD_PARTY requires a workforce resource following
+// locations/{location}/workforcePools/{pool}[/providers/{provider}]; GSUITE and
+// IDP_TYPE_UNSPECIFIED (which disables the identity provider) take none.
+func (c *GeminiClient) SetWorkforceIdentityConfig(idpType, workforceResource string) (*WorkforceIdentityConfig, error) {
 	if c.config == nil {
 		return nil, fmt.Errorf("client configuration is missing")
 	}
+	if err := validateIdpConfig(idpType, workforceResource); err != nil {
+		return nil, err
+	}
 
 	name := fmt.Sprintf("projects/%s/locations/%s/aclConfig", c.config.ProjectID, c.config.Location)
 	request := &discoveryengine.GoogleCloudDiscoveryengineV1AclConfig{
 		Name: name,
+		IdpConfig: &discoveryengine.GoogleCloudDiscoveryengineV1IdpConfig{
+			IdpType: idpType,
+		},
 	}
-
-	if strings.TrimSpace(workforceResource) == "" {
-		request.IdpConfig = &discoveryengine.GoogleCloudDiscoveryengineV1IdpConfig{
-			IdpType: idpTypeUnspecified,
-		}
-	} else {
-		request.IdpConfig = &discoveryengine.GoogleCloudDiscoveryengineV1IdpConfig{
-			IdpType: idpTypeThirdParty,
-			ExternalIdpConfig: &discoveryengine.GoogleCloudDiscoveryengineV1IdpConfigExternalIdpConfig{
-				WorkforcePoolName: workforceResource,
-			},
+	if idpType == IdpTypeThirdParty {
+		request.IdpConfig.ExternalIdpConfig = &discoveryengine.GoogleCloudDiscoveryengineV1IdpConfigExternalIdpConfig{
+			WorkforcePoolName: workforceResource,
 		}
 	}
 
@@ -91,6 +95,86 @@ func (c *GeminiClient) SetWorkforceIdentityConfig(workforceResource string) (*Wo
 	return result, nil
 }
 
+// NewWorkforceIdentityConfig builds the configuration that setting the given
+// IdP type and workforce resource would produce.
+func NewWorkforceIdentityConfig(idpType, workforceResource string) *WorkforceIdentityConfig {
+	cfg := &WorkforceIdentityConfig{IdpType: idpType}
+	if idpType == IdpTypeThirdParty {
+		cfg.WorkforcePoolName = workforceResource
+		populateDerivedWorkforceFields(cfg)
+	}
+	return cfg
+}
+
+// ParseIdpType converts a --idp value (google, third-party, none) into an API IdP type.
+func ParseIdpType(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "google", "gsuite", "workspace", strings.ToLower(IdpTypeGSuite):
+		return IdpTypeGSuite, nil
+	case "third-party", "third_party", "thirdparty", "workforce":
+		return IdpTypeThirdParty, nil
+	case "none", "unspecified", strings.ToLower(IdpTypeUnspecified):
+		return IdpTypeUnspecified, nil
+	default:
+		return "", fmt.Errorf("invalid IdP type %q (use google, third-party, or none)", value)
+	}
+}
+
+// EffectiveIdpType returns the configured IdP type, treating an empty value as unspecified.
+func (cfg *WorkforceIdentityConfig) EffectiveIdpType() string {
+	if cfg == nil || cfg.IdpType == "" {
+		return IdpTypeUnspecified
+	}
+	return cfg.IdpType
+}
+
+// IdpTypeLabel returns a human-readable description of the IdP type.
+func (cfg *WorkforceIdentityConfig) IdpTypeLabel() string {
+	switch idpType := cfg.EffectiveIdpType(); idpType {
+	case IdpTypeGSuite:
+		return "Google identity (GSUITE)"
+	case IdpTypeThirdParty:
+		return "Third-party workforce pool (THIRD_PARTY)"
+	case IdpTypeUnspecified:
+		return "Not configured (IDP_TYPE_UNSPECIFIED)"
+	default:
+		return idpType
+	}
+}
+
+// ListACLDataStores returns the data stores in the current project/location that
+// have document ACLs enabled. Their ACLs are bound to the configured IdP type.
+func (c *GeminiClient) ListACLDataStores() ([]*DataStore, error) {
+	dataStores, err := c.ListDataStores()
+	if err != nil {
+		return nil, err
+	}
+	aclDataStores := []*DataStore{}
+	for _, dataStore := range dataStores {
+		if dataStore.AclEnabled {
+			aclDataStores = append(aclDataStores, dataStore)
+		}
+	}
+	return aclDataStores, nil
+}
+
+func validateIdpConfig(idpType, workforceResource string) error {
+	hasResource := strings.TrimSpace(workforceResource) != ""
+	switch idpType {
+	case IdpTypeThirdParty:
+		if !hasResource {
+			return fmt.Errorf("a workforce pool resource is required for the %s IdP type", IdpTypeThirdParty)
+		}
+	case IdpTypeGSuite, IdpTypeUnspecified:
+		if hasResource {
+			return fmt.Errorf("a workforce pool resource cannot be used with the %s IdP type", idpType)
+		}
+	default:
+		return fmt.Errorf("unsupported IdP type %q", idpType)
+	}
+	return nil
+}
+
 func populateDerivedWorkforceFields(cfg *WorkforceIdentityConfig) {
 	if cfg == nil {
 		return
@@ -118,3 +202,46 @@ func populateDerivedWorkforceFields(cfg *WorkforceIdentityConfig) {
 		}
 	}
 }
+
+// WorkforcePrincipal builds an IAM member string for a user or group. When a
+// third-party workforce pool is configured the identifier is mapped to a
+// principal:// or principalSet:// URI in that pool; otherwise the Google
+// identity forms user:ID and group:ID are returned.
+func (cfg *WorkforceIdentityConfig) WorkforcePrincipal(kind, id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" && kind != "all" {
+		return "", fmt.Errorf("%s identifier is required", kind)
+	}
+
+	if !cfg.usesWorkforcePool() {
+		switch kind {
+		case "user", "group":
+			return fmt.Sprintf("%s:%s", kind, id), nil
+		default:
+			return "", fmt.Errorf("%s principals require a workforce identity pool", kind)
+		}
+	}
+
+	poolPath := fmt.Sprintf("iam.googleapis.com/%s/workforcePools/%s", cfg.workforceLocationOrDefault(), cfg.WorkforcePoolID)
+	switch kind {
+	case "user":
+		return fmt.Sprintf("principal://%s/subject/%s", poolPath, id), nil
+	case "group":
+		return fmt.Sprintf("principalSet://%s/group/%s", poolPath, id), nil
+	case "all":
+		return fmt.Sprintf("principalSet://%s/*", poolPath), nil
+	default:
+		return "", fmt.Errorf("unsupported principal kind %q", kind)
+	}
+}
+
+func (cfg *WorkforceIdentityConfig) usesWorkforcePool() bool {
+	return cfg != nil && cfg.IdpType == IdpTypeThirdParty && cfg.WorkforcePoolID != ""
+}
+
+func (cfg *WorkforceIdentityConfig) workforceLocationOrDefault() string {
+	if cfg.WorkforceLocation != "" {
+		return cfg.WorkforceLocation
+	}
+	return defaultWorkforceLocation
+}
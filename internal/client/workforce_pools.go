@@ -0,0 +1,331 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	iamBaseURL            = "https://iam.googleapis.com/v1"
+	workforceStateActive  = "ACTIVE"
+	workforceSubjectClaim = "google.subject"
+	workforceGroupsClaim  = "google.groups"
+)
+
+// WorkforcePool describes an IAM workforce identity pool.
+type WorkforcePool struct {
+	Name            string `json:"name"`
+	DisplayName     string `json:"displayName,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Parent          string `json:"parent,omitempty"`
+	State           string `json:"state,omitempty"`
+	Disabled        bool   `json:"disabled,omitempty"`
+	SessionDuration string `json:"sessionDuration,omitempty"`
+}
+
+// WorkforcePoolProvider describes an identity provider within a workforce pool.
+type WorkforcePoolProvider struct {
+	Name               string                     `json:"name"`
+	DisplayName        string                     `json:"displayName,omitempty"`
+	Description        string                     `json:"description,omitempty"`
+	State              string                     `json:"state,omitempty"`
+	Disabled           bool                       `json:"disabled,omitempty"`
+	AttributeMapping   map[string]string          `json:"attributeMapping,omitempty"`
+	AttributeCondition string                     `json:"attributeCondition,omitempty"`
+	Oidc               *WorkforcePoolProviderOidc `json:"oidc,omitempty"`
+	Saml               *WorkforcePoolProviderSaml `json:"saml,omitempty"`
+}
+
+// WorkforcePoolProviderOidc holds OIDC provider settings.
+type WorkforcePoolProviderOidc struct {
+	IssuerURI string `json:"issuerUri,omitempty"`
+	ClientID  string `json:"clientId,omitempty"`
+}
+
+// WorkforcePoolProviderSaml holds SAML provider settings.
+type WorkforcePoolProviderSaml struct {
+	IdpMetadataXML string `json:"idpMetadataXml,omitempty"`
+}
+
+// Protocol reports whether the provider uses OIDC or SAML.
+func (p *WorkforcePoolProvider) Protocol() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.Oidc != nil:
+		return "OIDC"
+	case p.Saml != nil:
+		return "SAML"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Enabled reports whether the provider is active and not disabled.
+func (p *WorkforcePoolProvider) Enabled() bool {
+	return p != nil && !p.Disabled && (p.State == "" || p.State == workforceStateActive)
+}
+
+// WorkforcePoolDetails bundles a pool with its providers.
+type WorkforcePoolDetails struct {
+	Pool      *WorkforcePool           `json:"pool"`
+	Providers []*WorkforcePoolProvider `json:"providers,omitempty"`
+}
+
+// WorkforceValidationReport collects the checks run against a workforce pool resource.
+type WorkforceValidationReport struct {
+	Resource string            `json:"resource"`
+	Checks   []ValidationCheck `json:"checks"`
+}
+
+// HasFailures reports whether any check failed.
+func (r *WorkforceValidationReport) HasFailures() bool {
+	if r == nil {
+		return false
+	}
+	for _, check := range r.Checks {
+		if check.Status == ValidationFail {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *WorkforceValidationReport) add(name, status, message, fix string) {
+	r.Checks = append(r.Checks, ValidationCheck{Name: name, Status: status, Message: message, Fix: fix})
+}
+
+// GetWorkforcePool retrieves a workforce pool, e.g. locations/global/workforcePools/POOL.
+func (c *GeminiClient) GetWorkforcePool(poolName string) (*WorkforcePool, error) {
+	body, err := c.doAPIRequest("iam", http.MethodGet, fmt.Sprintf("%s/%s", iamBaseURL, strings.TrimPrefix(poolName, "/")), nil)
+	if err != nil {
+		return nil, err
+	}
+	var pool WorkforcePool
+	if err := json.Unmarshal(body, &pool); err != nil {
+		return nil, fmt.Errorf("failed to decode workforce pool: %w", err)
+	}
+	return &pool, nil
+}
+
+// GetWorkforcePoolProvider retrieves a single workforce pool provider.
+func (c *GeminiClient) GetWorkforcePoolProvider(providerName string) (*WorkforcePoolProvider, error) {
+	body, err := c.doAPIRequest("iam", http.MethodGet, fmt.Sprintf("%s/%s", iamBaseURL, strings.TrimPrefix(providerName, "/")), nil)
+	if err != nil {
+		return nil, err
+	}
+	var provider WorkforcePoolProvider
+	if err := json.Unmarshal(body, &provider); err != nil {
+		return nil, fmt.Errorf("failed to decode workforce pool provider: %w", err)
+	}
+	return &provider, nil
+}
+
+// ListWorkforcePoolProviders lists the providers configured in a workforce pool.
+func (c *GeminiClient) ListWorkforcePoolProviders(poolName string) ([]*WorkforcePoolProvider, error) {
+	baseURL := fmt.Sprintf("%s/%s/providers", iamBaseURL, strings.TrimPrefix(poolName, "/"))
+
+	providers := []*WorkforcePoolProvider{}
+	pageToken := ""
+	for {
+		pageURL := baseURL
+		if pageToken != "" {
+			pageURL = fmt.Sprintf("%s?pageToken=%s", baseURL, url.QueryEscape(pageToken))
+		}
+
+		body, err := c.doAPIRequest("iam", http.MethodGet, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response struct {
+			WorkforcePoolProviders []*WorkforcePoolProvider `json:"workforcePoolProviders"`
+			NextPageToken          string                   `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode workforce pool providers: %w", err)
+		}
+		providers = append(providers, response.WorkforcePoolProviders...)
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+	return providers, nil
+}
+
+// DescribeWorkforcePool returns a pool and either the named provider or all of its providers.
+func (c *GeminiClient) DescribeWorkforcePool(resource string) (*WorkforcePoolDetails, error) {
+	poolName, providerID, err := ParseWorkforceResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := c.GetWorkforcePool(poolName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workforce pool %s: %w", poolName, err)
+	}
+
+	details := &WorkforcePoolDetails{Pool: pool}
+	if providerID != "" {
+		provider, err := c.GetWorkforcePoolProvider(poolName + "/providers/" + providerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workforce provider %s: %w", providerID, err)
+		}
+		details.Providers = []*WorkforcePoolProvider{provider}
+		return details, nil
+	}
+
+	providers, err := c.ListWorkforcePoolProviders(poolName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workforce providers: %w", err)
+	}
+	details.Providers = providers
+	return details, nil
+}
+
+// ValidateWorkforceResource confirms that a workforce pool (and provider, when
+// given) exists, is enabled, and maps the attributes Gemini Enterprise needs.
+func (c *GeminiClient) ValidateWorkforceResource(resource string) *WorkforceValidationReport {
+	report := &WorkforceValidationReport{Resource: resource}
+
+	poolName, providerID, err := ParseWorkforceResource(resource)
+	if err != nil {
+		report.add("resource-format", ValidationFail, err.Error(),
+			"Use locations/LOCATION/workforcePools/POOL_ID[/providers/PROVIDER_ID]")
+		return report
+	}
+	report.add("resource-format", ValidationPass, "Resource name is well formed", "")
+
+	pool, err := c.GetWorkforcePool(poolName)
+	switch code := httpStatusCode(err); {
+	case err == nil:
+		report.add("pool-exists", ValidationPass, fmt.Sprintf("Found workforce pool %q", valueOrDefault(pool.DisplayName, poolName)), "")
+		if pool.Disabled || (pool.State != "" && pool.State != workforceStateActive) {
+			report.add("pool-enabled", ValidationFail,
+				fmt.Sprintf("Workforce pool is not usable (state %s, disabled %t)", valueOrDefault(pool.State, "UNKNOWN"), pool.Disabled),
+				fmt.Sprintf("gcloud iam workforce-pools update %s --location=%s --no-disabled", extractResourceID(poolName), workforcePoolLocation(poolName)))
+		} else {
+			report.add("pool-enabled", ValidationPass, "Workforce pool is active", "")
+		}
+	case code == http.StatusNotFound:
+		report.add("pool-exists", ValidationFail, fmt.Sprintf("Workforce pool %s was not found", poolName),
+			"Check the pool ID and location with: gcloud iam workforce-pools list --organization=ORG_ID --location=global")
+		return report
+	case code == http.StatusForbidden:
+		report.add("pool-exists", ValidationSkip, "Permission denied reading the workforce pool",
+			"Grant yourself roles/iam.workforcePoolViewer on the organization to validate the pool")
+		return report
+	default:
+		report.add("pool-exists", ValidationSkip, fmt.Sprintf("Could not read workforce pool: %v", err), "")
+		return report
+	}
+
+	var providers []*WorkforcePoolProvider
+	if providerID != "" {
+		provider, err := c.GetWorkforcePoolProvider(poolName + "/providers/" + providerID)
+		switch code := httpStatusCode(err); {
+		case err == nil:
+			providers = []*WorkforcePoolProvider{provider}
+			report.add("provider-exists", ValidationPass, fmt.Sprintf("Found %s provider %q", provider.Protocol(), providerID), "")
+		case code == http.StatusNotFound:
+			report.add("provider-exists", ValidationFail, fmt.Sprintf("Provider %s was not found in the pool", providerID),
+				fmt.Sprintf("List providers with: gcloud iam workforce-pools providers list --workforce-pool=%s --location=%s",
+					extractResourceID(poolName), workforcePoolLocation(poolName)))
+			return report
+		default:
+			report.add("provider-exists", ValidationSkip, fmt.Sprintf("Could not read workforce provider: %v", err), "")
+			return report
+		}
+	} else {
+		providers, err = c.ListWorkforcePoolProviders(poolName)
+		if err != nil {
+			report.add("provider-exists", ValidationSkip, fmt.Sprintf("Could not list workforce providers: %v", err), "")
+			return report
+		}
+		if len(providers) == 0 {
+			report.add("provider-exists", ValidationFail, "Workforce pool has no identity providers; users will not be able to sign in",
+				"Add an OIDC or SAML provider to the pool before linking it")
+			return report
+		}
+		report.add("provider-exists", ValidationPass, fmt.Sprintf("Workforce pool has %d provider(s)", len(providers)), "")
+	}
+
+	enabled := []*WorkforcePoolProvider{}
+	for _, provider := range providers {
+		if provider.Enabled() {
+			enabled = append(enabled, provider)
+		}
+	}
+	if len(enabled) == 0 {
+		report.add("provider-enabled", ValidationFail, "No enabled identity provider found in the pool",
+			"Enable the provider with: gcloud iam workforce-pools providers update-oidc|update-saml PROVIDER_ID --no-disabled")
+		return report
+	}
+	report.add("provider-enabled", ValidationPass, fmt.Sprintf("%d provider(s) enabled", len(enabled)), "")
+
+	for _, provider := range enabled {
+		providerName := extractResourceID(provider.Name)
+		if _, ok := provider.AttributeMapping[workforceSubjectClaim]; !ok {
+			report.add("attribute-mapping", ValidationFail,
+				fmt.Sprintf("Provider %s does not map %s", providerName, workforceSubjectClaim),
+				"Map google.subject to a stable user identifier, e.g. assertion.sub")
+			continue
+		}
+		if _, ok := provider.AttributeMapping[workforceGroupsClaim]; !ok {
+			report.add("attribute-mapping", ValidationWarn,
+				fmt.Sprintf("Provider %s does not map %s; group-based sharing will not work", providerName, workforceGroupsClaim),
+				"Map google.groups to the groups claim of your identity provider")
+			continue
+		}
+		report.add("attribute-mapping", ValidationPass,
+			fmt.Sprintf("Provider %s maps %s and %s", providerName, workforceSubjectClaim, workforceGroupsClaim), "")
+	}
+
+	return report
+}
+
+// ParseWorkforceResource splits locations/L/workforcePools/P[/providers/X] into
+// the pool name and optional provider ID.
+func ParseWorkforceResource(resource string) (poolName, providerID string, err error) {
+	parts := strings.Split(strings.Trim(strings.TrimSpace(resource), "/"), "/")
+	valid := (len(parts) == 4 || len(parts) == 6) &&
+		parts[0] == "locations" && parts[1] != "" &&
+		parts[2] == "workforcePools" && parts[3] != ""
+	if valid && len(parts) == 6 {
+		valid = parts[4] == "providers" && parts[5] != ""
+	}
+	if !valid {
+		return "", "", fmt.Errorf("workforce resource %q must be in the form locations/LOCATION/workforcePools/POOL_ID[/providers/PROVIDER_ID]", resource)
+	}
+
+	poolName = strings.Join(parts[:4], "/")
+	if len(parts) == 6 {
+		providerID = parts[5]
+	}
+	return poolName, providerID, nil
+}
+
+func workforcePoolLocation(poolName string) string {
+	parts := strings.Split(poolName, "/")
+	if len(parts) > 1 {
+		return parts[1]
+	}
+	return "global"
+}
+
+func valueOrDefault(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}